@@ -0,0 +1,29 @@
+package admonitions
+
+import (
+	"github.com/yuin/goldmark/util"
+)
+
+// screenReaderLabel returns the label of n for screen readers. Classes
+// without a text in ScreenReaderTexts fall back to the classes they inherit
+// from, and finally to the capitalised class, e.g. "Warning:".
+func (r *Renderer) screenReaderLabel(n *Admonition) string {
+	for _, class := range kindChain(r.Kinds, string(n.AdmonitionClass)) {
+		if label, ok := r.ScreenReaderTexts[class]; ok {
+			return label
+		}
+	}
+	return string(capitalize(n.AdmonitionClass)) + ":"
+}
+
+// writeScreenReaderLabel writes the label of n as a visually hidden span,
+// which screen readers announce even if a theme hides the title
+func (r *Renderer) writeScreenReaderLabel(w util.BufWriter, n *Admonition) {
+	label := r.screenReaderLabel(n)
+	if label == "" || label == ":" {
+		return
+	}
+	_, _ = w.WriteString(`  <span class="sr-only">`)
+	_, _ = w.Write(util.EscapeHTML([]byte(label)))
+	_, _ = w.WriteString("</span>\n")
+}
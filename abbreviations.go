@@ -0,0 +1,161 @@
+package admonitions
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// An Abbreviation struct represents an abbreviated term in the body of an
+// admonition.
+type Abbreviation struct {
+	ast.BaseInline
+	Term      []byte
+	Expansion []byte
+}
+
+// Dump implements Node.Dump .
+func (n *Abbreviation) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Term":      string(n.Term),
+		"Expansion": string(n.Expansion),
+	}, nil)
+}
+
+// KindAbbreviation is a NodeKind of the Abbreviation node.
+var KindAbbreviation = ast.NewNodeKind("Abbreviation")
+
+// Kind implements Node.Kind.
+func (n *Abbreviation) Kind() ast.NodeKind {
+	return KindAbbreviation
+}
+
+// NewAbbreviation returns a new Abbreviation node.
+func NewAbbreviation(term, expansion []byte) *Abbreviation {
+	return &Abbreviation{Term: term, Expansion: expansion}
+}
+
+// abbreviationPattern matches any of the terms as a whole word, longer terms
+// first
+func abbreviationPattern(abbreviations map[string]string) *regexp.Regexp {
+	if len(abbreviations) == 0 {
+		return nil
+	}
+	terms := make([]string, 0, len(abbreviations))
+	for term := range abbreviations {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if len(terms[i]) != len(terms[j]) {
+			return len(terms[i]) > len(terms[j])
+		}
+		return terms[i] < terms[j]
+	})
+	pattern := ""
+	for i, term := range terms {
+		if i > 0 {
+			pattern += "|"
+		}
+		pattern += regexp.QuoteMeta(term)
+	}
+	return regexp.MustCompile(`\b(?:` + pattern + `)\b`)
+}
+
+// abbreviationsTransformer splits the texts of admonition bodies into the
+// abbreviated terms and the text around them. Code is left alone.
+type abbreviationsTransformer struct {
+	abbreviations map[string]string
+	pattern       *regexp.Regexp // matches the terms of abbreviations, compiled by Extend
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *abbreviationsTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	if t.pattern == nil {
+		return
+	}
+	source := reader.Source()
+
+	var texts []*ast.Text
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if node.Kind() == ast.KindCodeSpan {
+			return ast.WalkSkipChildren, nil
+		}
+		if n, ok := node.(*ast.Text); ok && insideAdmonition(n) && !n.IsRaw() {
+			mergeFollowingTexts(n)
+			texts = append(texts, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range texts {
+		value := n.Segment.Value(source)
+		parent := n.Parent()
+		start := 0
+		for _, match := range t.pattern.FindAllIndex(value, -1) {
+			if match[0] > start {
+				before := ast.NewTextSegment(text.NewSegment(n.Segment.Start+start, n.Segment.Start+match[0]))
+				parent.InsertBefore(parent, n, before)
+			}
+			term := value[match[0]:match[1]]
+			parent.InsertBefore(parent, n, NewAbbreviation(term, []byte(t.abbreviations[string(term)])))
+			start = match[1]
+		}
+		n.Segment = n.Segment.WithStart(n.Segment.Start + start)
+	}
+}
+
+// renderAbbreviation renders an abbreviation as <abbr>. Confluence storage
+// format has no such element, so only the term is written there.
+func (r *Renderer) renderAbbreviation(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		n := node.(*Abbreviation)
+		r.writeAbbreviation(w, n.Term, n.Expansion)
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) writeAbbreviation(w util.BufWriter, term, expansion []byte) {
+	if r.Target == TargetConfluence {
+		_, _ = w.Write(util.EscapeHTML(term))
+		return
+	}
+	_, _ = w.WriteString(`<abbr title="`)
+	_, _ = w.Write(util.EscapeHTML(expansion))
+	_, _ = w.WriteString(`">`)
+	_, _ = w.Write(util.EscapeHTML(term))
+	_, _ = w.WriteString(`</abbr>`)
+}
+
+// writeTitleText writes the escaped title with its abbreviations expanded,
+// unless NoTitleAbbreviations is set, and its references to tickets linked
+func (r *Renderer) writeTitleText(w util.BufWriter, title []byte) {
+	if len(r.TicketLinks) > 0 {
+		r.writeTitleTickets(w, title)
+		return
+	}
+	r.writeTitleAbbreviations(w, title)
+}
+
+// writeTitleAbbreviations writes the escaped title with its abbreviations
+// expanded
+func (r *Renderer) writeTitleAbbreviations(w util.BufWriter, title []byte) {
+	if r.abbreviationPattern == nil || r.NoTitleAbbreviations {
+		r.writeText(w, title)
+		return
+	}
+	start := 0
+	for _, match := range r.abbreviationPattern.FindAllIndex(title, -1) {
+		r.writeText(w, title[start:match[0]])
+		term := title[match[0]:match[1]]
+		r.writeAbbreviation(w, term, []byte(r.Abbreviations[string(term)]))
+		start = match[1]
+	}
+	r.writeText(w, title[start:])
+}
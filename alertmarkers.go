@@ -0,0 +1,108 @@
+package admonitions
+
+import (
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// strictAlertLine matches the alert markers WithStrictAlertMarkers accepts,
+// exactly "[!NOTE]" or "[!WARNING:2]" and not followed by a colon
+var strictAlertLine = regexp.MustCompile(`^[ \t]*\[![A-Za-z]+(?::[0-9]+)?\](?:[^:]|$)`)
+
+// colonAlertLine matches alert markers followed by a colon, e.g. "[!NOTE]:",
+// which goldmark would take for a link reference definition, with the name in
+// the first group
+var colonAlertLine = regexp.MustCompile(`^[ \t]*\[[ \t]*![ \t]*([A-Za-z]+)[ \t]*\]:`)
+
+// colonMarkersKey maps the blockquotes whose marker line colonMarkerTransformer
+// took out of their first paragraph to that line
+var colonMarkersKey = parser.NewContextKey()
+
+// colonMarkerTransformer keeps alert markers followed by a colon from becoming
+// link reference definitions. It runs before goldmark's
+// LinkReferenceParagraphTransformer and takes the marker line out of the
+// first paragraph of blockquotes, blockQuoteTransformer puts it back.
+type colonMarkerTransformer struct{}
+
+// Transform implements parser.ParagraphTransformer.Transform .
+func (t *colonMarkerTransformer) Transform(node *ast.Paragraph, reader text.Reader, pc parser.Context) {
+	quote, ok := node.Parent().(*ast.Blockquote)
+	lines := node.Lines()
+	if !ok || quote.FirstChild() != node || lines.Len() == 0 {
+		return
+	}
+	first := lines.At(0)
+	// other names stay link reference definitions, e.g. "[!docs]: /docs"
+	match := colonAlertLine.FindSubmatch(first.Value(reader.Source()))
+	if match == nil || ghAlertsClassifier.ClassifyingBlockQuote("!"+string(match[1])) == None {
+		return
+	}
+
+	markers, _ := pc.Get(colonMarkersKey).(map[ast.Node]text.Segment)
+	if markers == nil {
+		markers = map[ast.Node]text.Segment{}
+		pc.Set(colonMarkersKey, markers)
+	}
+	markers[quote] = first
+	if lines.Len() == 1 {
+		quote.RemoveChild(quote, node)
+		return
+	}
+	node.SetLines(text.NewSegments())
+	node.Lines().AppendAll(lines.Sliced(1, lines.Len()))
+}
+
+// restoreColonMarkers puts the marker lines colonMarkerTransformer took out
+// back into their blockquotes, as paragraphs of their own
+func restoreColonMarkers(pc parser.Context, source []byte) {
+	markers, _ := pc.Get(colonMarkersKey).(map[ast.Node]text.Segment)
+	for quote, line := range markers {
+		paragraph := ast.NewParagraph()
+		paragraph.Lines().Append(line)
+		paragraph.AppendChild(paragraph, ast.NewTextSegment(line.TrimRightSpace(source)))
+		quote.InsertBefore(quote, quote.FirstChild(), paragraph)
+	}
+	pc.Set(colonMarkersKey, nil)
+}
+
+// strictMarker reports whether the alert marker quote starts with, if any, is
+// written as GitHub requires, see WithStrictAlertMarkers
+func strictMarker(quote ast.Node, source []byte) bool {
+	block := firstTextBlock(quote)
+	if block == nil || alertType(quote, source) == nil {
+		return true
+	}
+	line := block.Lines().At(0)
+	return strictAlertLine.Match(withoutCodeSpans(line.Value(source)))
+}
+
+// removeLinePrefix removes the inlines of paragraph making up the source
+// before stop, which have to be texts, trimming the one stop falls into. It
+// returns whether they were removed and whether they end the line.
+func removeLinePrefix(paragraph *ast.Paragraph, stop int) (removed, endsLine bool) {
+	for child := paragraph.FirstChild(); child != nil; child = child.NextSibling() {
+		t, ok := child.(*ast.Text)
+		if !ok {
+			return false, false
+		}
+		if t.Segment.Stop >= stop || t.SoftLineBreak() || t.HardLineBreak() {
+			break
+		}
+	}
+
+	for child := paragraph.FirstChild(); child != nil; child = paragraph.FirstChild() {
+		t := child.(*ast.Text)
+		if t.Segment.Stop > stop {
+			t.Segment = t.Segment.WithStart(stop)
+			return true, false
+		}
+		paragraph.RemoveChild(paragraph, t)
+		if t.SoftLineBreak() || t.HardLineBreak() || t.Segment.Stop == stop {
+			return true, t.SoftLineBreak() || t.HardLineBreak()
+		}
+	}
+	return true, true
+}
@@ -0,0 +1,52 @@
+package admonitions
+
+import (
+	"github.com/yuin/goldmark/ast"
+)
+
+// approvedByAttribute is the attribute recording who signed off an admonition,
+// e.g. !!!danger Security {approved-by="Jane Doe"}
+var approvedByAttribute = []byte("approved-by")
+
+// ApprovedBy returns the value of the approved-by attribute and whether it is
+// present and not empty
+func (n *Admonition) ApprovedBy() (string, bool) {
+	value, ok := n.Attribute(approvedByAttribute)
+	if !ok {
+		return "", false
+	}
+	approver, ok := value.([]byte)
+	return string(approver), ok && len(approver) > 0
+}
+
+// FindUnapproved returns all admonitions of doc without an approved-by
+// attribute. If classes are given only admonitions of these classes are
+// checked, e.g. FindUnapproved(doc, "danger", "warning").
+func FindUnapproved(doc ast.Node, classes ...string) []*Admonition {
+	var unapproved []*Admonition
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		n, ok := node.(*Admonition)
+		if !ok || !entering || !hasClass(n, classes) {
+			return ast.WalkContinue, nil
+		}
+		if _, approved := n.ApprovedBy(); !approved {
+			unapproved = append(unapproved, n)
+		}
+		return ast.WalkContinue, nil
+	})
+	return unapproved
+}
+
+// hasClass reports whether n is of one of the classes. Every admonition
+// matches an empty list.
+func hasClass(n *Admonition, classes []string) bool {
+	if len(classes) == 0 {
+		return true
+	}
+	for _, class := range classes {
+		if string(n.AdmonitionClass) == class {
+			return true
+		}
+	}
+	return false
+}
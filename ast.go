@@ -1,7 +1,10 @@
 package admonitions
 
 import (
+	"bytes"
+
 	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
 )
 
 // A Admonition struct represents a fenced code block of Markdown text.
@@ -9,6 +12,31 @@ type Admonition struct {
 	ast.BaseBlock
 	AdmonitionClass []byte
 	Title           []byte
+	Opener          text.Segment // the source of the opening line
+	Body            text.Segment // the source between the opening and the closing line
+	Closer          text.Segment // the source of the closing line, empty if closed otherwise
+	AlertType       []byte       // the type of the alert marker as written, e.g. "BUG" for "> [!BUG]"
+	DefaultOpen     bool         // whether the marker expands a collapsible admonition by default, e.g. "> [!note]+" or "???+ note"
+	Severity        int          // the severity given with the marker, e.g. 2 for "!!!2 warning" or "> [!WARNING:2]", 0 without one
+
+	// Options are the key=value pairs of the line following the opening
+	// line, e.g. "icon=rocket open=true", see WithOptionsLine
+	Options map[string]string
+
+	marker        []byte       // what made this an admonition, see Marker
+	markerSegment text.Segment // where it is in the source
+
+	unterminated bool // whether the closing fence is missing
+}
+
+// rawClass is the class of admonitions whose body is written to the output
+// verbatim instead of being parsed
+var rawClass = []byte("raw")
+
+// IsRaw implements Node.IsRaw. The body of "!!!raw" admonitions isn't parsed
+// but kept in Lines.
+func (n *Admonition) IsRaw() bool {
+	return bytes.Equal(n.AdmonitionClass, rawClass)
 }
 
 // Dump implements Node.Dump .
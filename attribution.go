@@ -0,0 +1,89 @@
+package admonitions
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/util"
+)
+
+// The attributes naming the origin of an admonition, e.g.
+// !!!quote {source="The Go Programming Language"}
+var (
+	sourceAttribute = []byte("source")
+	citeAttribute   = []byte("cite")
+)
+
+// quoteClass is the class of admonitions whose body is a blockquote
+var quoteClass = []byte("quote")
+
+// Source returns the value of the source attribute, or the cite attribute if
+// there is none, and whether either is present and not empty
+func (n *Admonition) Source() (string, bool) {
+	for _, name := range [][]byte{sourceAttribute, citeAttribute} {
+		if value, ok := n.Attribute(name); ok {
+			if source, ok := value.([]byte); ok && len(source) > 0 {
+				return string(source), true
+			}
+		}
+	}
+	return "", false
+}
+
+// isURL reports whether source is an absolute http(s) URL
+func isURL(source string) bool {
+	s := []byte(source)
+	return bytes.HasPrefix(s, []byte("https://")) || bytes.HasPrefix(s, []byte("http://"))
+}
+
+// bodyTag returns the element the body of n is rendered as. The body of quote
+// admonitions is a blockquote.
+func bodyTag(n *Admonition) string {
+	if bytes.Equal(n.AdmonitionClass, quoteClass) {
+		return "blockquote"
+	}
+	return "div"
+}
+
+// writeBodyOpening opens the body of n, unless it's title-only. A blockquote
+// body cites the source of n if it is a URL.
+func (r *Renderer) writeBodyOpening(w util.BufWriter, n *Admonition) {
+	if r.isTitleOnly(n) {
+		return
+	}
+	tag := bodyTag(n)
+	_, _ = w.WriteString("  <" + tag + r.classAttribute("adm-body", string(n.AdmonitionClass)))
+	if source, ok := n.Source(); ok && tag == "blockquote" && isURL(source) {
+		_, _ = w.WriteString(" cite=\"")
+		_, _ = w.Write(util.EscapeHTML([]byte(source)))
+		_, _ = w.WriteString("\"")
+	}
+	_, _ = w.WriteString(">\n")
+}
+
+// writeBodyClosing closes the body of n, unless it's title-only
+func (r *Renderer) writeBodyClosing(w util.BufWriter, n *Admonition) {
+	if r.isTitleOnly(n) {
+		return
+	}
+	_, _ = w.WriteString("  </" + bodyTag(n) + ">\n")
+}
+
+// writeAttribution writes the citation line of admonitions with a source
+func (r *Renderer) writeAttribution(w util.BufWriter, n *Admonition) {
+	source, ok := n.Source()
+	if !ok {
+		return
+	}
+	escaped := util.EscapeHTML([]byte(source))
+	_, _ = w.WriteString("  <div" + r.classAttribute("adm-attribution", string(n.AdmonitionClass)) + ">&mdash; <cite>")
+	if isURL(source) {
+		_, _ = w.WriteString("<a href=\"")
+		_, _ = w.Write(escaped)
+		_, _ = w.WriteString("\">")
+		_, _ = w.Write(escaped)
+		_, _ = w.WriteString("</a>")
+	} else {
+		_, _ = w.Write(escaped)
+	}
+	_, _ = w.WriteString("</cite></div>\n")
+}
@@ -0,0 +1,93 @@
+package admonitions
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/yuin/goldmark"
+)
+
+// An Input is a Markdown document to convert with ConvertAll
+type Input struct {
+	Name   string // identifies the document, e.g. its path
+	Source []byte
+}
+
+// An Output is the HTML converted from the Input of the same Name
+type Output struct {
+	Name string
+	HTML []byte
+}
+
+// ConvertAll converts all inputs with a goldmark instance using the Extender
+// configured by opts. The outputs are in the order of the inputs.
+func ConvertAll(ctx context.Context, inputs []Input, opts ...Option) ([]Output, error) {
+	extender := New(opts...)
+	return ConvertAllWith(ctx, inputs, func() goldmark.Markdown {
+		return goldmark.New(goldmark.WithExtensions(extender))
+	})
+}
+
+// ConvertAllWith converts all inputs concurrently, using one goldmark
+// instance created by newMarkdown per worker. This lets you add other
+// extensions. The first error stops the conversion and is returned, as is the
+// error of ctx once it's done.
+func ConvertAllWith(ctx context.Context, inputs []Input, newMarkdown func() goldmark.Markdown) ([]Output, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(inputs) {
+		workers = len(inputs)
+	}
+
+	outputs := make([]Output, len(inputs))
+	jobs := make(chan int)
+
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			markdown := newMarkdown()
+			for i := range jobs {
+				var buf bytes.Buffer
+				if err := markdown.Convert(inputs[i].Source, &buf); err != nil {
+					fail(err)
+					continue
+				}
+				outputs[i] = Output{Name: inputs[i].Name, HTML: buf.Bytes()}
+			}
+		}()
+	}
+
+feed:
+	for i := range inputs {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
@@ -0,0 +1,80 @@
+package admonitions
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// BoldLabel decides what happens to the rest of the line of a blockquote
+// starting with a bold label like "**Warning:**", see WithBoldLabels
+type BoldLabel int
+
+const (
+	BoldLabelBody  BoldLabel = iota // the rest of the line stays in the body
+	BoldLabelTitle                  // the rest of the line becomes the title
+)
+
+// labelWord matches the text of a bold label, a single word with an optional
+// colon
+var labelWord = regexp.MustCompile(`^\s*([A-Za-z]+)\s*(:?)\s*$`)
+
+// stripBoldLabel removes a bold label of type bqType from the start of the
+// first paragraph of quote, e.g.
+//
+//	> **Warning:** Don't touch this.
+//
+// and returns the rest of its line as the title with BoldLabelTitle. Labels
+// that don't classify as bqType are kept.
+func stripBoldLabel(quote ast.Node, bqType BlockQuoteType, policy BoldLabel, source []byte) []byte {
+	paragraph, ok := quote.FirstChild().(*ast.Paragraph)
+	if !ok {
+		return nil
+	}
+	emphasis, ok := paragraph.FirstChild().(*ast.Emphasis)
+	if !ok || emphasis.Level != 2 {
+		return nil
+	}
+	label := labelWord.FindSubmatch(plainText(emphasis, source))
+	if label == nil || legacyClassifier.ClassifyingBlockQuote(string(label[1])) != bqType {
+		return nil
+	}
+
+	// the label may be on a line of its own
+	labelLine := endsLine(emphasis)
+	paragraph.RemoveChild(paragraph, emphasis)
+
+	// "**Warning**: ..." has the colon outside of the label
+	if next, ok := paragraph.FirstChild().(*ast.Text); ok && !labelLine {
+		value := next.Segment.Value(source)
+		trimmed := bytes.TrimLeft(value, " \t")
+		if len(label[2]) == 0 {
+			trimmed = bytes.TrimLeft(bytes.TrimPrefix(trimmed, []byte(":")), " \t")
+		}
+		next.Segment = next.Segment.WithStart(next.Segment.Start + len(value) - len(trimmed))
+		if next.Segment.Len() == 0 && endsLine(next) {
+			paragraph.RemoveChild(paragraph, next)
+			labelLine = true
+		}
+	}
+
+	var title []byte
+	if policy == BoldLabelTitle && !labelLine {
+		title = takeLine(paragraph, source)
+	}
+
+	if paragraph.FirstChild() == nil {
+		quote.RemoveChild(quote, paragraph)
+	}
+	return bytes.TrimSpace(title)
+}
+
+// endsLine reports whether the inline node ends with a line break
+func endsLine(node ast.Node) bool {
+	for node.LastChild() != nil {
+		node = node.LastChild()
+	}
+	t, ok := node.(*ast.Text)
+	return ok && (t.SoftLineBreak() || t.HardLineBreak())
+}
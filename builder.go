@@ -0,0 +1,118 @@
+package admonitions
+
+// Syntax is a way of writing admonitions, see Builder.Syntax
+type Syntax int
+
+const (
+	SyntaxFenced     Syntax = iota // !!!note Title, the default
+	SyntaxGHAlerts                 // > [!NOTE], see WithBlockQuoteAdmonitions
+	SyntaxDirectives               // :::note Title, see WithDirectives
+	SyntaxMkDocs                   // !!! note "Title", see WithMkDocs
+	SyntaxPandoc                   // ::: {.note}, see WithPandocDivs
+	SyntaxReST                     // .. note::, see WithReStructuredText
+)
+
+// A Builder composes the configuration of an Extender step by step, e.g.
+// from the settings of a site loaded from YAML or JSON:
+//
+//	ext := admonitions.NewBuilder().
+//		Syntax(admonitions.SyntaxGHAlerts).
+//		Target(admonitions.TargetHTML).
+//		Type("security", admonitions.Kind{Inherits: "warning"}).
+//		Build()
+//
+// Every method returns the Builder itself. Build can be called repeatedly,
+// later changes to the Builder don't affect the Extenders built before.
+type Builder struct {
+	syntaxes []Syntax
+	kinds    map[string]Kind
+	aliases  map[string]string
+	opts     []Option
+}
+
+// NewBuilder returns a Builder of the Extender New returns
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// Syntax adds syntaxes admonitions are parsed in. Once it is called, only the
+// added syntaxes are parsed, SyntaxFenced included. SyntaxMkDocs implies
+// SyntaxFenced, both start with "!!!".
+func (b *Builder) Syntax(syntaxes ...Syntax) *Builder {
+	b.syntaxes = append(b.syntaxes, syntaxes...)
+	return b
+}
+
+// Target sets the output format, see WithTarget
+func (b *Builder) Target(target Target) *Builder {
+	return b.Option(WithTarget(target))
+}
+
+// Type adds the custom class configured by kind, see WithKinds
+func (b *Builder) Type(class string, kind Kind) *Builder {
+	if b.kinds == nil {
+		b.kinds = map[string]Kind{}
+	}
+	b.kinds[class] = kind
+	return b
+}
+
+// Alias makes alias a name of class, see WithTypeAliases
+func (b *Builder) Alias(alias, class string) *Builder {
+	if b.aliases == nil {
+		b.aliases = map[string]string{}
+	}
+	b.aliases[alias] = class
+	return b
+}
+
+// Option adds options the Builder has no method for. They apply in the order
+// they are added, together with Target, after Syntax, Type and Alias.
+func (b *Builder) Option(opts ...Option) *Builder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Build returns a new Extender configured by b
+func (b *Builder) Build() *Extender {
+	var opts []Option
+	if b.syntaxes != nil {
+		var markers []string
+		for _, syntax := range b.syntaxes {
+			switch syntax {
+			case SyntaxFenced:
+				markers = append(markers, markerFence)
+			case SyntaxGHAlerts:
+				markers = append(markers, markerQuote)
+			case SyntaxDirectives:
+				markers = append(markers, markerColons)
+				opts = append(opts, WithDirectives())
+			case SyntaxMkDocs:
+				markers = append(markers, markerFence)
+				opts = append(opts, WithMkDocs())
+			case SyntaxPandoc:
+				markers = append(markers, markerColons)
+				opts = append(opts, WithPandocDivs())
+			case SyntaxReST:
+				markers = append(markers, markerRST)
+				opts = append(opts, WithReStructuredText())
+			}
+		}
+		opts = append(opts, WithMarkers(markers...))
+	}
+	if b.kinds != nil {
+		kinds := make(map[string]Kind, len(b.kinds))
+		for class, kind := range b.kinds {
+			kinds[class] = kind
+		}
+		opts = append(opts, WithKinds(kinds))
+	}
+	if b.aliases != nil {
+		aliases := make(map[string]string, len(b.aliases))
+		for alias, class := range b.aliases {
+			aliases[alias] = class
+		}
+		opts = append(opts, WithTypeAliases(aliases))
+	}
+	return New(append(opts, b.opts...)...)
+}
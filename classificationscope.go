@@ -0,0 +1,79 @@
+package admonitions
+
+import (
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// ClassificationScope decides where in a blockquote legacy keywords like
+// "note" classify it, see WithClassificationScope. Alert markers like
+// "[!NOTE]" have to start the first line regardless.
+type ClassificationScope int
+
+const (
+	ClassifyFirstBlock  ClassificationScope = iota // the first line of a paragraph, every line of an HTML block
+	ClassifyFirstLine                              // the first line of the first block only
+	ClassifyFirstText                              // the first text of the first paragraph, up to an emphasis, link or code span, e.g. "Note" of "> **Note** See the notes"
+	ClassifyLeadingWord                            // the first word of the first line, e.g. "Note" of "> **Note:** Take note" but nothing of "> All fine. Take note"
+)
+
+// leadingKeyword matches the leading words converting blockquotes classified
+// by legacy keywords without WithKeywordsAnywhere
+var leadingKeyword = regexp.MustCompile(`(?i)^(info|note|tip|warn|warning)$`)
+
+// leadingClassification reports whether quote of type bqType starts with what
+// classified it: an alert marker or a legacy keyword as its leading word, e.g.
+// "> Note: ..." but not "> There are multiple reasons", a tip by its keyword
+func leadingClassification(quote ast.Node, bqType BlockQuoteType, source []byte) bool {
+	if bqType == Step || bqType == Custom {
+		return true
+	}
+	block := firstTextBlock(quote)
+	if block == nil || block.Kind() == ast.KindHTMLBlock {
+		return false
+	}
+	first := block.Lines().At(0)
+	line := withoutCodeSpans(first.Value(source))
+	if marker := alertLine.FindSubmatch(line); marker != nil && ghAlertsClassifier.ClassifyingBlockQuote("!"+string(marker[1])) == bqType {
+		return true
+	}
+	return leadingKeyword.Match(leadingWord(line))
+}
+
+// leadingWord returns the first run of letters of line, skipping what comes
+// before it like "**" or "> "
+func leadingWord(line []byte) []byte {
+	start := -1
+	for i := 0; i < len(line); {
+		c, size := utf8.DecodeRune(line[i:])
+		if unicode.IsLetter(c) {
+			if start < 0 {
+				start = i
+			}
+		} else if start >= 0 {
+			return line[start:i]
+		}
+		i += size
+	}
+	if start < 0 {
+		return nil
+	}
+	return line[start:]
+}
+
+// firstText returns the source of the first text within block, nil if there
+// is none
+func firstText(block ast.Node, source []byte) []byte {
+	var value []byte
+	_ = ast.Walk(block, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if t, ok := node.(*ast.Text); ok && entering {
+			value = t.Segment.Value(source)
+			return ast.WalkStop, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return value
+}
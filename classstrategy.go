@@ -0,0 +1,80 @@
+package admonitions
+
+import "strings"
+
+// ClassStrategy names the classes this package emits, e.g. to follow a BEM
+// convention or to emit the utility classes of a CSS framework instead
+type ClassStrategy interface {
+	// Class returns the space separated classes replacing class, one of the
+	// classes this package emits like "admonition" or "adm-title", for an
+	// admonition of type admonitionType, which is empty if unknown
+	Class(class, admonitionType string) string
+}
+
+// ClassStrategyFunc adapts a function to ClassStrategy
+type ClassStrategyFunc func(class, admonitionType string) string
+
+// Class calls f(class, admonitionType)
+func (f ClassStrategyFunc) Class(class, admonitionType string) string {
+	return f(class, admonitionType)
+}
+
+// bemElements are the parts of admonitions, named as BEM elements
+var bemElements = map[string]bool{
+	"title":       true,
+	"icon":        true,
+	"body":        true,
+	"footer":      true,
+	"attribution": true,
+	"details":     true,
+	"open":        true,
+	"more":        true,
+	"keys":        true,
+	"metadata":    true,
+	"definitions": true,
+}
+
+// BEMClasses names classes by the BEM convention, with the type as modifier,
+// e.g. "admonition admonition--warning" for the wrapper and
+// "admonition__title admonition__title--warning" for its title. Modifiers
+// like adm-title-only become "admonition--title-only", inline admonitions
+// are "admonition-inline admonition-inline--warning".
+var BEMClasses ClassStrategy = ClassStrategyFunc(bemClass)
+
+func bemClass(class, admonitionType string) string {
+	var block, element string
+	switch {
+	case admonitionType != "" && class == "adm-"+admonitionType:
+		// The block already carries the type as modifier
+		return ""
+	case class == "admonition", class == "admonition-inline":
+		block = class
+	case bemElements[strings.TrimPrefix(class, "adm-")]:
+		block, element = "admonition", "__"+strings.TrimPrefix(class, "adm-")
+	default:
+		return "admonition--" + strings.TrimPrefix(class, "adm-")
+	}
+	if admonitionType == "" {
+		return block + element
+	}
+	return block + element + " " + block + element + "--" + admonitionType
+}
+
+// UtilityClasses emits the classes mapped to the classes this package emits,
+// e.g. Tailwind utilities. A key "adm-title:warning" maps the title of
+// warnings only and takes precedence over "adm-title". Classes without
+// a mapping are kept, map them to "" to drop them. Elements left without
+// classes are rendered without a class attribute.
+func UtilityClasses(classes map[string]string) ClassStrategy {
+	return ClassStrategyFunc(func(class, admonitionType string) string {
+		if admonitionType != "" {
+			if mapped, ok := classes[class+":"+admonitionType]; ok {
+				return mapped
+			}
+		}
+		if mapped, ok := classes[class]; ok {
+			return mapped
+		}
+		return class
+	})
+}
@@ -0,0 +1,148 @@
+package admonitions
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// CollapseScript restores and remembers the open state of the collapsible
+// admonitions of a page in localStorage, keyed by their data-adm-key, which
+// the wrappers get with WithResponsive or WithCollapseScript. Include
+// it in your bundle or let WithCollapseScript write it into the page.
+const CollapseScript = `document.addEventListener("DOMContentLoaded", function () {
+  document.querySelectorAll("details[data-adm-key], [data-adm-key] details").forEach(function (details) {
+    var key = "adm:" + details.closest("[data-adm-key]").dataset.admKey;
+    var state = localStorage.getItem(key);
+    if (state !== null) details.open = state === "1";
+    details.addEventListener("toggle", function () {
+      localStorage.setItem(key, details.open ? "1" : "0");
+    });
+  });
+});`
+
+// admonitionKey returns the data-adm-key of n, which is unique within its
+// document and stays the same as long as its id, or class and title, do
+func (r *Renderer) admonitionKey(n *Admonition, source []byte) string {
+	state := r.state(n)
+	if state.keys == nil {
+		state.keys = admonitionKeys(documentRoot(n), source, r)
+	}
+	return state.keys[n]
+}
+
+// admonitionKeys returns the keys of all admonitions of root. Repeated keys
+// are numbered in document order.
+func admonitionKeys(root ast.Node, source []byte, r *Renderer) map[*Admonition]string {
+	keys := map[*Admonition]string{}
+	seen := map[string]int{}
+	_ = ast.Walk(root, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		n, ok := node.(*Admonition)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		var key string
+		if id, ok := n.AttributeString("id"); ok {
+			key = slug(string(attributeBytes(id)))
+		}
+		if key == "" {
+			key = slug(string(n.AdmonitionClass) + " " + string(r.title(n)))
+		}
+		if key == "" {
+			key = n.Hash(source)[:8]
+		}
+		seen[key]++
+		if seen[key] > 1 {
+			key = fmt.Sprintf("%s-%d", key, seen[key])
+		}
+		keys[n] = key
+		return ast.WalkContinue, nil
+	})
+	return keys
+}
+
+// slug returns s in lower case with runs of anything but letters and digits
+// replaced by a single "-"
+func slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, c := range strings.ToLower(s) {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(c)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	return b.String()
+}
+
+// writeCollapseScript writes CollapseScript in front of the first admonition
+// of every document
+func (r *Renderer) writeCollapseScript(w util.BufWriter, n *Admonition) {
+	state := r.state(n)
+	if state.script {
+		return
+	}
+	state.script = true
+	_, _ = w.WriteString("<script>\n" + CollapseScript + "\n</script>\n")
+}
+
+// collapseAttribute makes an admonition collapsible, closed unless its value
+// is "open", e.g. !!!note Details {collapsible=open}. MkDocs' ??? and ???+ set
+// it, as do the "-" and "+" of Obsidian callouts like "> [!note]+". It isn't
+// named collapse, which is left to ConfluenceParameters.
+var collapseAttribute = []byte("collapsible")
+
+// Collapsible reports whether n has a collapsible attribute and whether it is
+// expanded by default
+func (n *Admonition) Collapsible() (open bool, ok bool) {
+	value, ok := n.Attribute(collapseAttribute)
+	if !ok {
+		return false, false
+	}
+	return string(attributeBytes(value)) == "open", true
+}
+
+// renderCollapsible renders a collapsible admonition as a <details> element
+// with the title as its <summary>
+func (r *Renderer) renderCollapsible(w util.BufWriter, source []byte, n *Admonition, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		r.writeBodyClosing(w, n)
+		r.writeAttribution(w, n)
+		r.writeFooter(w, n)
+		_, _ = w.WriteString("</details>\n")
+		return ast.WalkContinue, nil
+	}
+
+	if r.CollapseScript {
+		r.writeCollapseScript(w, n)
+	}
+	r.writeWrapperElement(w, source, n, "details")
+	r.writeTitle(w, n, "summary")
+	r.writeBodyOpening(w, n)
+	return ast.WalkContinue, nil
+}
+
+// writeConfluenceExpand opens an expand macro around collapsed admonitions,
+// Confluence has no way to expand one by default
+func (r *Renderer) writeConfluenceExpand(w util.BufWriter, n *Admonition, entering bool) {
+	if open, ok := n.Collapsible(); !ok || open {
+		return
+	}
+	if !entering {
+		_, _ = w.WriteString("</ac:rich-text-body></ac:structured-macro>\n")
+		return
+	}
+	_, _ = w.WriteString(`<ac:structured-macro ac:name="expand">`)
+	if title := r.title(n); len(title) > 0 {
+		writeConfluenceParameter(w, "title", title)
+	}
+	_, _ = w.WriteString("<ac:rich-text-body>\n")
+}
@@ -0,0 +1,159 @@
+package admonitions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// A ConfigFile is the declarative configuration FromConfig reads, written as
+// JSON or YAML:
+//
+//	syntaxes: [fenced, gh-alerts]
+//	target: html
+//	theme: default
+//	types:
+//	  security:
+//	    inherits: warning
+//	aliases:
+//	  warn: warning
+//	icons:
+//	  security:
+//	    iconify: "mdi:shield"
+//	    unicode: "🛡"
+type ConfigFile struct {
+	// Syntaxes are "fenced", "gh-alerts", "directives", "mkdocs", "pandoc"
+	// and "rst", see Builder.Syntax. All configured ones if empty.
+	Syntaxes []string `json:"syntaxes"`
+
+	// Target is "html", the default, "confluence" or "web-component"
+	Target string `json:"target"`
+
+	// Theme is "default" for DefaultIcons, no icons but those of Icons if
+	// empty
+	Theme string `json:"theme"`
+
+	// Types are custom classes, see Kind
+	Types map[string]ConfigType `json:"types"`
+
+	// Aliases map classes to the class they are written for, e.g.
+	// "warn: warning", see WithTypeAliases
+	Aliases map[string]string `json:"aliases"`
+
+	// Icons are the icons of classes, see WithIconChains
+	Icons map[string]IconChain `json:"icons"`
+}
+
+// A ConfigType is a Kind in a ConfigFile
+type ConfigType struct {
+	Inherits   string `json:"inherits"`
+	Confluence string `json:"confluence"` // the Confluence macro
+}
+
+// The names of the syntaxes, targets and themes of ConfigFile
+var (
+	configSyntaxes = map[string]Syntax{
+		"fenced":     SyntaxFenced,
+		"gh-alerts":  SyntaxGHAlerts,
+		"directives": SyntaxDirectives,
+		"mkdocs":     SyntaxMkDocs,
+		"pandoc":     SyntaxPandoc,
+		"rst":        SyntaxReST,
+	}
+	configTargets = map[string]Target{
+		"":              TargetHTML,
+		"html":          TargetHTML,
+		"confluence":    TargetConfluence,
+		"web-component": TargetWebComponent,
+	}
+	configThemes = map[string]map[string]Icon{
+		"":        nil,
+		"default": DefaultIcons,
+	}
+)
+
+// FromConfig reads a ConfigFile from r, JSON if it starts with "{" and YAML
+// otherwise, and returns the Extender it configures. Unknown fields, syntaxes,
+// targets and themes are errors.
+func FromConfig(r io.Reader) (*Extender, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("admonitions: config: %w", err)
+	}
+	if data = bytes.TrimSpace(data); !bytes.HasPrefix(data, []byte("{")) {
+		// YAML is decoded as JSON, so both share the json tags of ConfigFile
+		var tree interface{}
+		if err := yaml.Unmarshal(data, &tree); err != nil {
+			return nil, fmt.Errorf("admonitions: config: %w", err)
+		}
+		if tree == nil {
+			tree = map[string]interface{}{}
+		}
+		if data, err = json.Marshal(tree); err != nil {
+			return nil, fmt.Errorf("admonitions: config: %w", err)
+		}
+	}
+
+	var c ConfigFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&c); err != nil {
+		return nil, fmt.Errorf("admonitions: config: %w", err)
+	}
+	b, err := c.Builder()
+	if err != nil {
+		return nil, err
+	}
+	return b.Build(), nil
+}
+
+// Builder returns a Builder configured by c, to add options a ConfigFile has
+// no field for
+func (c *ConfigFile) Builder() (*Builder, error) {
+	b := NewBuilder()
+	for _, name := range c.Syntaxes {
+		syntax, ok := configSyntaxes[name]
+		if !ok {
+			return nil, fmt.Errorf("admonitions: config: unknown syntax %q", name)
+		}
+		b.Syntax(syntax)
+	}
+
+	target, ok := configTargets[c.Target]
+	if !ok {
+		return nil, fmt.Errorf("admonitions: config: unknown target %q", c.Target)
+	}
+	b.Target(target)
+
+	icons, ok := configThemes[c.Theme]
+	if !ok {
+		return nil, fmt.Errorf("admonitions: config: unknown theme %q", c.Theme)
+	}
+	if icons != nil {
+		b.Option(WithIcons(icons))
+	}
+
+	for class, t := range c.Types {
+		b.Type(class, Kind{Inherits: t.Inherits, ConfluenceMacro: t.Confluence})
+	}
+	aliases := make([]string, 0, len(c.Aliases))
+	for alias := range c.Aliases {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		if _, ok := c.Types[alias]; ok {
+			return nil, fmt.Errorf("admonitions: config: %q is both a type and an alias", alias)
+		}
+		b.Alias(alias, c.Aliases[alias])
+	}
+
+	if c.Icons != nil {
+		b.Option(WithIconChains(c.Icons, nil))
+	}
+	return b, nil
+}
@@ -0,0 +1,117 @@
+package admonitions
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// A Conflict is a node kind registered by several node renderers with the same
+// priority. goldmark sorts renderers by priority only, so which of them ends
+// up rendering the kind is left to chance.
+type Conflict struct {
+	Kind      ast.NodeKind
+	Priority  int
+	Renderers []renderer.NodeRenderer
+}
+
+// Error implements the error interface.
+func (c Conflict) Error() string {
+	names := ""
+	for i, r := range c.Renderers {
+		if i > 0 {
+			names += ", "
+		}
+		names += fmt.Sprintf("%T", r)
+	}
+	return fmt.Sprintf("admonitions: %s is rendered by %s with the same priority %d", c.Kind, names, c.Priority)
+}
+
+// conflictKinds are the kinds a conflict is reported for
+var conflictKinds = []ast.NodeKind{ast.KindBlockquote, KindAdmonition}
+
+// DetectConflicts returns the conflicts of the node renderers registered with
+// md for blockquotes and admonitions. Call it once all extensions have been
+// added.
+func DetectConflicts(md goldmark.Markdown) []Conflict {
+	var config *renderer.Config
+	md.Renderer().AddOptions(configCapture{&config})
+	return detectConflicts(config)
+}
+
+func detectConflicts(config *renderer.Config) []Conflict {
+	if config == nil {
+		return nil
+	}
+	claims := map[ast.NodeKind]map[int][]renderer.NodeRenderer{}
+	for _, v := range config.NodeRenderers {
+		nr, ok := v.Value.(renderer.NodeRenderer)
+		if !ok {
+			continue
+		}
+		rec := &kindRecorder{}
+		nr.RegisterFuncs(rec)
+		for _, kind := range rec.kinds {
+			if claims[kind] == nil {
+				claims[kind] = map[int][]renderer.NodeRenderer{}
+			}
+			claims[kind][v.Priority] = append(claims[kind][v.Priority], nr)
+		}
+	}
+
+	var conflicts []Conflict
+	for _, kind := range conflictKinds {
+		priorities := make([]int, 0, len(claims[kind]))
+		for priority := range claims[kind] {
+			priorities = append(priorities, priority)
+		}
+		sort.Ints(priorities)
+		for _, priority := range priorities {
+			if renderers := claims[kind][priority]; len(renderers) > 1 {
+				conflicts = append(conflicts, Conflict{Kind: kind, Priority: priority, Renderers: renderers})
+			}
+		}
+	}
+	return conflicts
+}
+
+// reportConflicts hands the conflicts of config to report and returns none,
+// or returns them if report is nil
+func reportConflicts(config *renderer.Config, report func(Conflict)) []Conflict {
+	conflicts := detectConflicts(config)
+	if report == nil {
+		return conflicts
+	}
+	for _, c := range conflicts {
+		report(c)
+	}
+	return nil
+}
+
+// renderConflict fails rendering the document with the first conflict found
+func (r *Renderer) renderConflict(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	return ast.WalkStop, r.conflicts[0]
+}
+
+// configCapture is a renderer option that keeps the configuration of the
+// renderer it's added to
+type configCapture struct {
+	config **renderer.Config
+}
+
+func (c configCapture) SetConfig(config *renderer.Config) {
+	*c.config = config
+}
+
+// kindRecorder records the kinds a node renderer registers functions for
+type kindRecorder struct {
+	kinds []ast.NodeKind
+}
+
+func (k *kindRecorder) Register(kind ast.NodeKind, _ renderer.NodeRendererFunc) {
+	k.kinds = append(k.kinds, kind)
+}
@@ -0,0 +1,206 @@
+package admonitions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// The namespaces of the ac and ri prefixes of Confluence storage format
+const (
+	confluenceNamespace   = "http://www.atlassian.com/schema/confluence/4/"
+	confluenceACNamespace = "http://www.atlassian.com/schema/confluence/4/ac/"
+	confluenceRINamespace = "http://www.atlassian.com/schema/confluence/4/ri/"
+)
+
+// confluenceMacros maps admonition classes to the Confluence macro they are
+// rendered as. Classes without an entry become info macros.
+var confluenceMacros = map[string]string{
+	"info":      "info",
+	"important": "info",
+	"note":      "note",
+	"tip":       "tip",
+	"warning":   "warning",
+	"warn":      "warning",
+	"danger":    "warning",
+	"caution":   "warning",
+}
+
+// confluenceStatusColours maps Confluence macros to the colour of the status
+// macro compact admonitions are rendered as
+var confluenceStatusColours = map[string]string{
+	"info":    "Blue",
+	"note":    "Yellow",
+	"tip":     "Green",
+	"warning": "Red",
+}
+
+// confluenceMacro returns the Confluence macro n is rendered as
+func (r *Renderer) confluenceMacro(n *Admonition) string {
+	if macro, ok := r.SeverityMacros[n.Severity]; ok && n.Severity > 0 {
+		return macro
+	}
+	return r.confluenceClassMacro(string(n.AdmonitionClass))
+}
+
+// confluenceClassMacro returns the Confluence macro of class
+func (r *Renderer) confluenceClassMacro(class string) string {
+	for _, class := range kindChain(r.Kinds, class) {
+		if kind := r.Kinds[class]; kind.ConfluenceMacro != "" {
+			return kind.ConfluenceMacro
+		}
+		if macro, ok := confluenceMacros[strings.ToLower(class)]; ok {
+			return macro
+		}
+	}
+	return "info"
+}
+
+// renderConfluence renders n as a Confluence structured macro in storage format
+func (r *Renderer) renderConfluence(w util.BufWriter, source []byte, n *Admonition, entering bool) (ast.WalkStatus, error) {
+	macro := r.confluenceMacro(n)
+
+	if text, ok := r.compactText(n, source); ok {
+		if entering {
+			_, _ = w.WriteString(`<p><ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">`)
+			_, _ = w.WriteString(confluenceStatusColours[macro])
+			_, _ = w.WriteString(`</ac:parameter><ac:parameter ac:name="title">`)
+			_, _ = w.Write(util.EscapeHTML(text))
+			_, _ = w.WriteString("</ac:parameter></ac:structured-macro></p>\n")
+		}
+		return ast.WalkSkipChildren, nil
+	}
+
+	if !entering {
+		if _, ok := n.Lang(); ok {
+			_, _ = w.WriteString("</div>\n")
+		}
+		_, _ = w.WriteString("</ac:rich-text-body></ac:structured-macro>\n")
+		r.writeConfluenceExpand(w, n, false)
+		return ast.WalkContinue, nil
+	}
+
+	r.writeConfluenceExpand(w, n, true)
+	params := r.confluenceParameters(n)
+	configured, _ := r.confluenceIcon(n)
+	icon, ok := params["icon"]
+	if !ok {
+		icon = []byte(fmt.Sprint(!configured.Hide))
+	}
+	title, ok := params["title"]
+	if !ok {
+		title = r.title(n)
+	}
+
+	_, _ = w.WriteString(`<ac:structured-macro ac:name="`)
+	// Without an icon there's no panel showing the emoji either
+	if configured.Emoji != "" && string(icon) != "false" {
+		_, _ = w.WriteString(`panel">`)
+		if configured.Shortname != "" {
+			writeConfluenceParameter(w, "panelIcon", []byte(configured.Shortname))
+		}
+		writeConfluenceParameter(w, "panelIconId", []byte(emojiID(configured.Emoji)))
+		writeConfluenceParameter(w, "panelIconText", []byte(configured.Emoji))
+		if configured.Background != "" {
+			writeConfluenceParameter(w, "bgColor", []byte(configured.Background))
+		}
+	} else {
+		_, _ = w.WriteString(macro)
+		_, _ = w.WriteString(`">`)
+		writeConfluenceParameter(w, "icon", icon)
+	}
+	if len(title) > 0 {
+		writeConfluenceParameter(w, "title", title)
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		if name != "icon" && name != "title" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeConfluenceParameter(w, name, params[name])
+	}
+	_, _ = w.WriteString("<ac:rich-text-body>\n")
+	writeConfluenceLang(w, n)
+	if len(r.ConfluenceDiagramMacros) > 0 && r.markdown != nil {
+		if err := r.writeConfluenceBody(w, source, n); err != nil {
+			return ast.WalkStop, err
+		}
+		return ast.WalkSkipChildren, nil
+	}
+	return ast.WalkContinue, nil
+}
+
+// confluenceParameters returns the macro parameters of n by name, taken from
+// its attributes as mapped by ConfluenceParameters
+func (r *Renderer) confluenceParameters(n *Admonition) map[string][]byte {
+	params := map[string][]byte{}
+	for attribute, param := range r.ConfluenceParameters {
+		if value, ok := n.AttributeString(attribute); ok {
+			params[param] = attributeBytes(value)
+		}
+	}
+	return params
+}
+
+func writeConfluenceParameter(w util.BufWriter, name string, value []byte) {
+	_, _ = w.WriteString(`<ac:parameter ac:name="`)
+	_, _ = w.Write(util.EscapeHTML([]byte(name)))
+	_, _ = w.WriteString(`">`)
+	_, _ = w.Write(util.EscapeHTML(value))
+	_, _ = w.WriteString(`</ac:parameter>`)
+}
+
+// compactText returns the text of n if it is short enough to be rendered as a
+// status macro: no title and a single paragraph of plain text of at most
+// CompactThreshold characters
+func (r *Renderer) compactText(n *Admonition, source []byte) ([]byte, bool) {
+	if r.CompactThreshold <= 0 || len(r.title(n)) > 0 || n.ChildCount() != 1 {
+		return nil, false
+	}
+	paragraph := n.FirstChild()
+	if paragraph.Kind() != ast.KindParagraph {
+		return nil, false
+	}
+
+	var text []byte
+	for child := paragraph.FirstChild(); child != nil; child = child.NextSibling() {
+		t, ok := child.(*ast.Text)
+		if !ok || t.SoftLineBreak() || t.HardLineBreak() {
+			return nil, false
+		}
+		text = append(text, t.Segment.Value(source)...)
+	}
+	if len([]rune(string(text))) > r.CompactThreshold {
+		return nil, false
+	}
+	return text, true
+}
+
+// renderConfluencePage wraps the document in an element declaring the
+// namespaces storage format uses, so the output is namespace-valid XML. The
+// declarations are always written in the same order for output to be
+// byte-for-byte comparable.
+func (r *Renderer) renderConfluencePage(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if r.ConfluenceOutput == ConfluenceWrapped {
+		if entering {
+			_, _ = w.WriteString(`<div xmlns:ac="` + confluenceACNamespace + `" xmlns:ri="` + confluenceRINamespace + "\">\n")
+		} else {
+			_, _ = w.WriteString("</div>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if entering {
+		_, _ = w.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+		_, _ = w.WriteString(`<ac:confluence xmlns="` + confluenceNamespace + `" xmlns:ac="` + confluenceACNamespace + `" xmlns:ri="` + confluenceRINamespace + "\">\n")
+	} else {
+		_, _ = w.WriteString("</ac:confluence>\n")
+	}
+	return ast.WalkContinue, nil
+}
@@ -0,0 +1,42 @@
+package admonitions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A ConfluenceIcon configures the icon of the Confluence macros of a class.
+// With an Emoji, admonitions are rendered as panel macros showing it instead
+// of the icon of their macro, unless Hide or an icon parameter of false hides
+// it:
+//
+//	"security": {Emoji: "🔒", Shortname: ":lock:", Background: "#FFEBE6"}
+type ConfluenceIcon struct {
+	Hide       bool   // renders icon=false, no icon at all
+	Emoji      string // the emoji of the panel, e.g. "🚀"
+	Shortname  string // its name in Confluence, e.g. ":rocket:"
+	Background string // the background colour of the panel, e.g. "#E3FCEF"
+}
+
+// confluenceIcon returns the ConfluenceIcon of n, classes inheriting from
+// others falling back to their icons
+func (r *Renderer) confluenceIcon(n *Admonition) (ConfluenceIcon, bool) {
+	for _, class := range kindChain(r.Kinds, string(n.AdmonitionClass)) {
+		if icon, ok := r.ConfluenceIcons[class]; ok {
+			return icon, true
+		}
+	}
+	return ConfluenceIcon{}, false
+}
+
+// emojiID returns the id Confluence identifies emoji by, the hexadecimal
+// code points joined by "-", e.g. "1f680" for "🚀"
+func emojiID(emoji string) string {
+	points := make([]string, 0, len(emoji))
+	for _, r := range emoji {
+		if r != '\ufe0f' { // the variation selector isn't part of the id
+			points = append(points, fmt.Sprintf("%x", r))
+		}
+	}
+	return strings.Join(points, "-")
+}
@@ -0,0 +1,55 @@
+package admonitions
+
+import (
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// containersTransformer turns admonitions a block of another kind than
+// allowed contains into plain blockquotes
+type containersTransformer struct {
+	allowed  map[ast.NodeKind]bool // the kinds of blocks admonitions may be in
+	report   func(error)           // receives the misplaced admonitions, as Problems if nil
+	failFast bool                  // whether they are Problems as well
+}
+
+func (t *containersTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+
+	var misplaced []*Admonition
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*Admonition); ok && entering && !t.allowed[n.Parent().Kind()] {
+			misplaced = append(misplaced, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range misplaced {
+		err := problemAt(n, source, "a %s admonition isn't allowed within %s, it's rendered as a blockquote", n.AdmonitionClass, n.Parent().Kind())
+		if t.report != nil {
+			t.report(err)
+		}
+		if t.report == nil || t.failFast {
+			n.Parent().InsertBefore(n.Parent(), n, NewProblemNode(err))
+		}
+		n.Parent().ReplaceChild(n.Parent(), n, plainBlockQuote(n))
+	}
+}
+
+// plainBlockQuote returns a blockquote with the children of n, led by its
+// title in bold
+func plainBlockQuote(n *Admonition) *ast.Blockquote {
+	quote := ast.NewBlockquote()
+	if len(n.Title) > 0 {
+		title := ast.NewEmphasis(2)
+		title.AppendChild(title, ast.NewString(n.Title))
+		paragraph := ast.NewParagraph()
+		paragraph.AppendChild(paragraph, title)
+		quote.AppendChild(quote, paragraph)
+	}
+	for child := n.FirstChild(); child != nil; child = n.FirstChild() {
+		quote.AppendChild(quote, child)
+	}
+	return quote
+}
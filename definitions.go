@@ -0,0 +1,128 @@
+package admonitions
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// definitionClasses are the classes of admonitions documenting APIs, whose
+// lists of "name: description" items are rendered as definition lists, e.g.
+//
+//	!!!params Parameters
+//	- `ctx`: the context of the request
+//	- `id`: the ID of the user
+//	!!!
+var definitionClasses = [][]byte{[]byte("params"), []byte("returns")}
+
+// isDefinitions reports whether the body of n is rendered as a definition
+// list: its class is one of definitionClasses and its body consists of lists
+// only
+func isDefinitions(n *Admonition) bool {
+	isClass := false
+	for _, class := range definitionClasses {
+		isClass = isClass || bytes.Equal(n.AdmonitionClass, class)
+	}
+	if !isClass || !n.HasChildren() {
+		return false
+	}
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		if child.Kind() != ast.KindList {
+			return false
+		}
+	}
+	return true
+}
+
+// renderDefinitions renders n with its body as a definition list
+func (r *Renderer) renderDefinitions(w util.BufWriter, source []byte, n *Admonition, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		r.writeAttribution(w, n)
+		r.writeFooter(w, n)
+		_, _ = w.WriteString("</div>\n")
+		return ast.WalkContinue, nil
+	}
+
+	r.writeWrapper(w, source, n)
+	r.writeTitle(w, n, r.titleTag(n))
+	if err := r.writeDefinitions(w, source, n); err != nil {
+		return ast.WalkStop, err
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+// writeDefinitions writes the list items of n as terms and descriptions. The
+// term is everything up to the first colon of an item, items without one are
+// descriptions only.
+func (r *Renderer) writeDefinitions(w util.BufWriter, source []byte, n *Admonition) error {
+	_, _ = w.WriteString("  <dl" + r.classAttribute("adm-body adm-definitions", string(n.AdmonitionClass)) + ">\n")
+	for list := n.FirstChild(); list != nil; list = list.NextSibling() {
+		for item := list.FirstChild(); item != nil; item = item.NextSibling() {
+			if err := r.writeDefinition(w, source, item); err != nil {
+				return err
+			}
+		}
+	}
+	_, _ = w.WriteString("  </dl>\n")
+	return nil
+}
+
+func (r *Renderer) writeDefinition(w util.BufWriter, source []byte, item ast.Node) error {
+	block := item.FirstChild()
+	if block == nil {
+		return nil
+	}
+
+	// Find the text with the colon ending the term
+	var colon *ast.Text
+	at := 0
+	for inline := block.FirstChild(); inline != nil && colon == nil; inline = inline.NextSibling() {
+		if t, ok := inline.(*ast.Text); ok {
+			if i := bytes.IndexByte(t.Segment.Value(source), ':'); i >= 0 {
+				colon, at = t, i
+			}
+		}
+	}
+
+	inline := block.FirstChild()
+	if colon != nil {
+		_, _ = w.WriteString("<dt>")
+		for ; inline != colon; inline = inline.NextSibling() {
+			if err := r.markdown.Render(w, source, inline); err != nil {
+				return err
+			}
+		}
+		segment := colon.Segment.WithStop(colon.Segment.Start + at)
+		term := ast.NewTextSegment(segment.TrimRightSpace(source))
+		if err := r.markdown.Render(w, source, term); err != nil {
+			return err
+		}
+		_, _ = w.WriteString("</dt>\n")
+	}
+
+	_, _ = w.WriteString("<dd>")
+	if colon != nil {
+		segment := colon.Segment.WithStart(colon.Segment.Start + at + 1)
+		description := ast.NewTextSegment(segment.TrimLeftSpace(source))
+		description.SetSoftLineBreak(colon.SoftLineBreak())
+		description.SetHardLineBreak(colon.HardLineBreak())
+		if err := r.markdown.Render(w, source, description); err != nil {
+			return err
+		}
+		inline = colon.NextSibling()
+	}
+	for ; inline != nil; inline = inline.NextSibling() {
+		if err := r.markdown.Render(w, source, inline); err != nil {
+			return err
+		}
+	}
+	// Further blocks of the item, e.g. a nested list
+	for more := block.NextSibling(); more != nil; more = more.NextSibling() {
+		if err := r.markdown.Render(w, source, more); err != nil {
+			return err
+		}
+	}
+	_, _ = w.WriteString("</dd>\n")
+	return nil
+}
@@ -0,0 +1,18 @@
+package admonitions
+
+import (
+	"github.com/yuin/goldmark/ast"
+)
+
+// This file keeps identifiers of earlier versions working that have been
+// superseded. They will be removed with the next major version at the
+// earliest.
+
+// ParseBlockQuoteType parses the first line of a blockquote and returns its
+// type.
+//
+// Deprecated: parse documents with the Extender and look the types up with
+// BlockQuoteTypes, which classifies every blockquote once while parsing.
+func ParseBlockQuoteType(node ast.Node, source []byte) BlockQuoteType {
+	return classifyBlockQuote(node, source, ClassifyFirstBlock)
+}
@@ -0,0 +1,46 @@
+package admonitions
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// diagramMacro returns the Confluence macro the fenced code block node is
+// rendered as, if it is a diagram listed in ConfluenceDiagramMacros, e.g.
+// ```mermaid
+func (r *Renderer) diagramMacro(node ast.Node, source []byte) (string, bool) {
+	block, ok := node.(*ast.FencedCodeBlock)
+	if !ok {
+		return "", false
+	}
+	macro, ok := r.ConfluenceDiagramMacros[string(block.Language(source))]
+	return macro, ok
+}
+
+// writeConfluenceBody renders the children of n, with diagrams as the macros
+// of ConfluenceDiagramMacros and everything else as usual
+func (r *Renderer) writeConfluenceBody(w util.BufWriter, source []byte, n *Admonition) error {
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		macro, ok := r.diagramMacro(child, source)
+		if !ok {
+			if err := r.markdown.Render(w, source, child); err != nil {
+				return err
+			}
+			continue
+		}
+
+		_, _ = w.WriteString(`<ac:structured-macro ac:name="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(macro)))
+		_, _ = w.WriteString(`"><ac:plain-text-body><![CDATA[`)
+		lines := child.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			line := lines.At(i)
+			// "]]>" would end the CDATA section early
+			_, _ = w.Write(bytes.ReplaceAll(line.Value(source), []byte("]]>"), []byte("]]]]><![CDATA[>")))
+		}
+		_, _ = w.WriteString("]]></ac:plain-text-body></ac:structured-macro>\n")
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package admonitions
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+)
+
+// A DigestEntry is an admonition extracted from a Markdown file
+type DigestEntry struct {
+	Path  string // the path of the file within the fs.FS
+	Line  int    // the line of the opening tag, starting at 1
+	Type  string // the admonition class
+	Title string
+	Body  []byte // the Markdown source of the body, without blockquote markers
+}
+
+// ExtractAdmonitions parses every Markdown file of fsys with md and returns
+// the admonitions for which filter returns true. A nil filter keeps all of
+// them.
+func ExtractAdmonitions(fsys fs.FS, md goldmark.Markdown, filter func(DigestEntry) bool) ([]DigestEntry, error) {
+	var entries []DigestEntry
+
+	err := walkMarkdownFiles(fsys, md, func(p string, source []byte, doc ast.Node, pc parser.Context) error {
+		return ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+			n, ok := node.(*Admonition)
+			if !ok || !entering {
+				return ast.WalkContinue, nil
+			}
+
+			entry := DigestEntry{
+				Path:  p,
+				Line:  lineAt(source, digestStart(n)),
+				Type:  string(n.AdmonitionClass),
+				Title: string(n.Title),
+				Body:  digestBody(n, source),
+			}
+			if filter == nil || filter(entry) {
+				entries = append(entries, entry)
+			}
+			return ast.WalkContinue, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// digestStart returns the offset of the opening line of n, or of its first
+// line for blockquotes without one, e.g. those starting with a heading
+func digestStart(n *Admonition) int {
+	if n.Opener.Len() > 0 {
+		return n.Opener.Start
+	}
+	start, _, _ := sourceRange(n)
+	return start
+}
+
+// digestBody returns the source of the body of n without as many blockquote
+// markers on every line as its opening line has, e.g. the "> " of GitHub
+// alerts. Without an opening line all of n is the body.
+func digestBody(n *Admonition, source []byte) []byte {
+	opener := digestStart(n)
+	lineStart := bytes.LastIndexByte(source[:opener], '\n') + 1
+	body := n.Body.Value(source)
+	if n.Opener.Len() == 0 {
+		_, stop, ok := sourceRange(n)
+		if !ok {
+			return nil
+		}
+		body = source[lineStart:stop]
+	}
+	depth := bytes.Count(source[lineStart:opener], []byte(">"))
+	if depth == 0 {
+		return body
+	}
+
+	lines := bytes.SplitAfter(body, []byte{'\n'})
+	for i, line := range lines {
+		for j := 0; j < depth; j++ {
+			trimmed := bytes.TrimLeft(line, " \t")
+			if len(trimmed) == 0 || trimmed[0] != '>' {
+				break
+			}
+			line = bytes.TrimPrefix(trimmed[1:], []byte(" "))
+		}
+		lines[i] = line
+	}
+	return bytes.Join(lines, nil)
+}
+
+// WriteDigest writes the entries as a Markdown document, e.g. a generated
+// "Known issues and cautions" page. Entries are grouped by type and every
+// one of them is followed by a link to its source.
+func WriteDigest(w io.Writer, title string, entries []DigestEntry) error {
+	groups := map[string][]DigestEntry{}
+	var types []string
+	for _, entry := range entries {
+		if _, ok := groups[entry.Type]; !ok {
+			types = append(types, entry.Type)
+		}
+		groups[entry.Type] = append(groups[entry.Type], entry)
+	}
+	sort.Strings(types)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n", title)
+	for _, t := range types {
+		fmt.Fprintf(&buf, "\n## %s\n", t)
+		for _, entry := range groups[t] {
+			fence := digestFence(entry.Body)
+			// untitled alerts have no space after their type
+			fmt.Fprintf(&buf, "\n%s\n", strings.TrimRight(fence+entry.Type+" "+entry.Title, " "))
+			if body := bytes.TrimRight(entry.Body, " \t\n"); len(body) > 0 {
+				buf.Write(body)
+				buf.WriteByte('\n')
+			}
+			fmt.Fprintf(&buf, "%s\n\nSource: [%s, line %d](%s#L%d)\n", fence, entry.Path, entry.Line, entry.Path, entry.Line)
+		}
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// digestFence returns a fence longer than any fence in body, so nested
+// admonitions don't close the digest entry
+func digestFence(body []byte) string {
+	length := 3
+	for _, line := range bytes.Split(body, []byte{'\n'}) {
+		line = bytes.TrimLeft(line, " \t")
+		i := 0
+		for ; i < len(line) && line[i] == '!'; i++ {
+		}
+		if i >= length {
+			length = i + 1
+		}
+	}
+	return strings.Repeat("!", length)
+}
@@ -0,0 +1,98 @@
+package admonitions
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+)
+
+// The probe documents of Doctor
+var (
+	doctorProbe    = []byte("!!!note Probe\nprobe body\n!!!\n")
+	doctorRawHTML  = []byte("!!!note\n<b>probe</b>\n!!!\n")
+	doctorRawProbe = []byte("!!!raw\n<i>probe</i>\n!!!\n")
+)
+
+// Doctor converts a few probe documents with md and returns the
+// misconfigurations they reveal, e.g. a missing Extender, another renderer
+// taking over admonitions or blockquotes, or WithUnsafe set without
+// html.WithUnsafe. html.WithUnsafe without WithUnsafe isn't reported, raw
+// admonitions are opted into separately. The Err of every Problem describes
+// it. Call it before md converts anything else, the renderer configuration
+// can't be inspected afterwards.
+func Doctor(md goldmark.Markdown) []Problem {
+	var problems []Problem
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, Problem{Err: fmt.Errorf("admonitions: "+format, args...)})
+	}
+
+	doc := md.Parser().Parse(text.NewReader(doctorProbe))
+	parsed := false
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		parsed = parsed || node.Kind() == KindAdmonition
+		return ast.WalkContinue, nil
+	})
+	if !parsed {
+		report("admonitions aren't parsed, the Extender isn't registered with this goldmark instance")
+		return problems
+	}
+
+	var config *renderer.Config
+	md.Renderer().AddOptions(configCapture{&config})
+	ours := doctorRenderer(config)
+	if config != nil {
+		if ours == nil {
+			report("another renderer takes over admonitions")
+		}
+		for _, conflict := range detectConflicts(config) {
+			problems = append(problems, Problem{Err: conflict})
+		}
+	}
+
+	var out bytes.Buffer
+	if err := md.Renderer().Render(&out, doctorProbe, doc); err != nil {
+		report("rendering an admonition fails: %v", err)
+		return problems
+	}
+	if !bytes.Contains(out.Bytes(), []byte("probe body")) {
+		report("the body of admonitions is missing from the output")
+	}
+
+	out.Reset()
+	rawHTML := md.Convert(doctorRawHTML, &out) == nil && !bytes.Contains(out.Bytes(), []byte("<b>probe</b>"))
+	out.Reset()
+	raw := md.Convert(doctorRawProbe, &out) == nil && !bytes.Contains(out.Bytes(), []byte("<i>probe</i>"))
+	if rawHTML && !raw {
+		report("admonitions.WithUnsafe is set but html.WithUnsafe isn't, raw HTML in admonitions is omitted")
+	}
+	return problems
+}
+
+// doctorRenderer returns the Renderer rendering admonitions with config, nil
+// if another renderer wins
+func doctorRenderer(config *renderer.Config) *Renderer {
+	if config == nil {
+		return nil
+	}
+	var winner renderer.NodeRenderer
+	priority := 0
+	for _, v := range config.NodeRenderers {
+		nr, ok := v.Value.(renderer.NodeRenderer)
+		if !ok || (winner != nil && v.Priority >= priority) {
+			continue
+		}
+		rec := &kindRecorder{}
+		nr.RegisterFuncs(rec)
+		for _, kind := range rec.kinds {
+			if kind == KindAdmonition {
+				winner, priority = nr, v.Priority
+			}
+		}
+	}
+	r, _ := winner.(*Renderer)
+	return r
+}
@@ -0,0 +1,49 @@
+package admonitions
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// ExcerptPolicy decides which admonitions an excerpt includes
+type ExcerptPolicy int
+
+const (
+	SkipAdmonitions ExcerptPolicy = iota // leave out all admonitions
+	FirstTipOnly                         // include the first tip, leave out all others
+)
+
+// tipClass is the class FirstTipOnly keeps
+var tipClass = []byte("tip")
+
+// Excerpt returns the plain text of the top level blocks of doc, without
+// headings, e.g. for summaries on list pages or in front matter. policy
+// decides which admonitions are included. The excerpt is cut after maxWords
+// words and ends with "…" then. A maxWords of 0 doesn't cut.
+func Excerpt(doc ast.Node, source []byte, maxWords int, policy ExcerptPolicy) string {
+	var words []string
+	tipIncluded := false
+
+	for block := doc.FirstChild(); block != nil; block = block.NextSibling() {
+		if block.Kind() == ast.KindHeading {
+			continue
+		}
+
+		if n, ok := block.(*Admonition); ok {
+			if policy != FirstTipOnly || tipIncluded || !bytes.Equal(n.AdmonitionClass, tipClass) {
+				continue
+			}
+			tipIncluded = true
+			words = append(words, strings.Fields(string(n.Title))...)
+		}
+
+		words = append(words, strings.Fields(string(plainText(block, source)))...)
+		if maxWords > 0 && len(words) > maxWords {
+			return strings.Join(words[:maxWords], " ") + "…"
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,104 @@
+package admonitions
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Expiry decides what happens to admonitions past the date of their expires
+// attribute, see WithExpiry
+type Expiry int
+
+const (
+	ExpiryIgnore  Expiry = iota // expires attributes have no effect, the default
+	ExpiryHide                  // expired admonitions aren't rendered
+	ExpiryArchive               // expired admonitions get the class adm-archived
+)
+
+// expiresAttribute is the last day an admonition is current on, e.g.
+// !!!warning Migration {expires=2025-06-30}
+var expiresAttribute = []byte("expires")
+
+// unquotedExpires matches an expires attribute with an unquoted value, which
+// goldmark's attribute parser rejects for dates like 2025-06-30
+var unquotedExpires = regexp.MustCompile(`(\bexpires=)([^\s"'{}]+)`)
+
+// parseAttributes is parser.ParseAttributes for the attributes on the line of
+// reader, also accepting unquoted values of expires attributes
+func parseAttributes(reader text.Reader) (parser.Attributes, bool) {
+	line, _ := reader.PeekLine()
+	matches := unquotedExpires.FindAllSubmatchIndex(line, -1)
+	if matches == nil {
+		return parser.ParseAttributes(reader)
+	}
+
+	quoted := unquotedExpires.ReplaceAll(line, []byte(`$1"$2"`))
+	quotedReader := text.NewReader(quoted)
+	attrs, ok := parser.ParseAttributes(quotedReader)
+	if !ok {
+		return nil, false
+	}
+	// the quotes added within the attributes don't advance reader
+	_, position := quotedReader.Position()
+	consumed := position.Start
+	for i, match := range matches {
+		if match[1]+2*(i+1) <= position.Start {
+			consumed -= 2
+		}
+	}
+	reader.Advance(consumed)
+	return attrs, true
+}
+
+// Expires returns the date of the expires attribute and whether it is a
+// valid date like 2025-06-30, in the location loc
+func (n *Admonition) Expires(loc *time.Location) (time.Time, bool) {
+	value, ok := n.Attribute(expiresAttribute)
+	if !ok {
+		return time.Time{}, false
+	}
+	date, err := time.ParseInLocation("2006-01-02", string(attributeBytes(value)), loc)
+	return date, err == nil
+}
+
+// isExpired reports whether the day the expires attribute of n names is over
+// by the clock of the Renderer
+func (r *Renderer) isExpired(n *Admonition) bool {
+	if r.Expiry == ExpiryIgnore {
+		return false
+	}
+	now := time.Now
+	if r.Clock != nil {
+		now = r.Clock
+	}
+	today := now()
+	date, ok := n.Expires(today.Location())
+	return ok && !today.Before(date.AddDate(0, 0, 1))
+}
+
+// expiresTransformer reports admonitions whose expires attribute isn't a date
+// as Problems
+type expiresTransformer struct{}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *expiresTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	var invalid []*Admonition
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*Admonition); ok && entering {
+			if _, ok := n.Attribute(expiresAttribute); ok {
+				if _, valid := n.Expires(time.UTC); !valid {
+					invalid = append(invalid, n)
+				}
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	for _, n := range invalid {
+		value, _ := n.Attribute(expiresAttribute)
+		insertProblem(n, reader.Source(), "the expires attribute %q isn't a date like 2025-06-30", attributeBytes(value))
+	}
+}
@@ -1,9 +1,13 @@
 package admonitions
 
 import (
+	"regexp"
+
 	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
 	"github.com/yuin/goldmark/util"
 )
 
@@ -26,7 +30,49 @@ import (
 // !!!
 // !!!
 type Extender struct {
-	priority int // optional int != 0. the priority value for parser and renderer. Defaults to 100.
+	priority int               // optional int != 0. the priority value for parser and renderer. Defaults to 100.
+	config   Config            // the configuration handed to the Renderer
+	vars     map[string]string // the values of {{name}} placeholders, if set
+
+	blockQuotes   bool           // whether classified blockquotes become admonitions
+	blockQuoteEnd BlockQuoteEnd  // where these admonitions end
+	exactMarkers  bool           // whether marker lines are kept as written
+	boldLabels    bool           // whether bold labels like "**Warning:**" are stripped
+	boldLabel     BoldLabel      // what becomes of the rest of their line
+	steps         *regexp.Regexp // the names of stepped alert markers
+	customAlerts  bool           // whether alerts of unknown types become admonitions
+	caseSensitive bool           // whether alert markers have to be uppercase
+	strictMarkers bool           // whether alert markers have to be written exactly as on GitHub
+
+	classificationScope ClassificationScope // where legacy keywords classify blockquotes
+	keywordsAnywhere    bool                // whether keywords anywhere in the first line convert blockquotes
+
+	randomIDs  bool // whether unclosed admonitions keep random IDs
+	mkdocs     bool // whether MkDocs admonitions are parsed
+	directives bool // whether ":::note" directives are parsed
+	pandoc     bool // whether "::: {.note}" Pandoc fenced divs are parsed
+	rst        bool // whether ".. note::" reStructuredText directives are parsed
+	inline     bool // whether "[!tip: text]" inline admonitions are parsed
+
+	markers map[string]bool // the active markers, all configured ones if nil
+
+	aliases map[string]string // the classes replaced by canonical ones
+
+	tabs bool // whether content tabs are parsed inside admonitions
+
+	numbering    bool // whether admonitions are numbered
+	sectionLevel int  // the deepest heading level restarting their numbers
+
+	restrictions *Restrictions // how untrusted admonitions are hardened, if at all
+
+	terminators Terminators // how fenced admonitions end
+	optionsLine bool        // whether a key=value line after the opener sets options
+
+	containers  map[ast.NodeKind]bool // the kinds of blocks admonitions may be in, anywhere if nil
+	onMisplaced func(error)           // where admonitions elsewhere are reported to, failing Convert if nil
+
+	checkConflicts bool           // whether renderer conflicts are reported
+	onConflict     func(Conflict) // where they are reported to, returned by Convert if nil
 }
 
 // This implements the Extend method for goldmark-admonitions.Extender
@@ -36,14 +82,171 @@ func (e *Extender) Extend(md goldmark.Markdown) {
 	if e.priority != 0 {
 		priority = e.priority
 	}
+	if e.parsesFences() {
+		md.Parser().AddOptions(
+			parser.WithBlockParsers(
+				util.Prioritized(&admonitionParser{randomIDs: e.randomIDs, terminators: e.terminators}, priority),
+			),
+		)
+	}
 	md.Parser().AddOptions(
-		parser.WithBlockParsers(
-			util.Prioritized(&admonitionParser{}, priority),
+		parser.WithASTTransformers(
+			util.Prioritized(&blockQuoteTransformer{convert: e.convertsBlockQuotes(), end: e.blockQuoteEnd, exactMarkers: e.exactMarkers, failFast: e.config.FailFast, boldLabels: e.boldLabels, boldLabel: e.boldLabel, steps: e.steps, customAlerts: e.customAlerts, caseSensitive: e.caseSensitive, strictMarkers: e.strictMarkers, scope: e.classificationScope, keywordsAnywhere: e.keywordsAnywhere}, priority),
 		),
 	)
+	// after blockquotes have become admonitions
+	md.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(&shortcutTransformer{}, priority+1),
+		),
+	)
+	if !e.strictMarkers {
+		// before goldmark's link reference definitions at 100
+		md.Parser().AddOptions(
+			parser.WithParagraphTransformers(
+				util.Prioritized(&colonMarkerTransformer{}, 99),
+			),
+		)
+	}
+	if e.parsesDirectives() {
+		md.Parser().AddOptions(
+			parser.WithBlockParsers(
+				util.Prioritized(&admonitionParser{randomIDs: e.randomIDs, char: ':', terminators: e.terminators}, priority),
+			),
+		)
+	}
+	if e.parsesPandoc() {
+		md.Parser().AddOptions(
+			parser.WithBlockParsers(
+				util.Prioritized(&admonitionParser{randomIDs: e.randomIDs, char: ':', pandoc: true, kinds: e.config.Kinds, terminators: e.terminators}, priority-1),
+			),
+		)
+	}
+	if e.parsesMkDocs() {
+		md.Parser().AddOptions(
+			parser.WithBlockParsers(
+				util.Prioritized(&mkdocsParser{}, priority-1),
+			),
+		)
+	}
+	if e.tabs {
+		md.Parser().AddOptions(
+			parser.WithBlockParsers(
+				util.Prioritized(&tabParser{}, priority-1),
+			),
+		)
+	}
+	if e.inline {
+		// before links, which start with "[" as well
+		md.Parser().AddOptions(
+			parser.WithInlineParsers(
+				util.Prioritized(&inlineParser{}, priority),
+			),
+		)
+	}
+	if e.parsesRST() {
+		md.Parser().AddOptions(
+			parser.WithBlockParsers(
+				util.Prioritized(&rstParser{}, priority-1),
+			),
+		)
+	}
+	if e.config.Kinds != nil || e.aliases != nil {
+		// after blockquotes have become admonitions
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&kindsTransformer{kinds: e.config.Kinds, aliases: e.aliases}, priority+1),
+			),
+		)
+	}
+	if e.config.Abbreviations != nil {
+		// after blockquotes have become admonitions
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&abbreviationsTransformer{abbreviations: e.config.Abbreviations, pattern: abbreviationPattern(e.config.Abbreviations)}, priority+1),
+			),
+		)
+	}
+	if len(e.config.TicketLinks) > 0 {
+		// after blockquotes have become admonitions
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&ticketsTransformer{links: e.config.TicketLinks}, priority+1),
+			),
+		)
+	}
+	if e.vars != nil {
+		// after blockquotes have become admonitions
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&varsTransformer{vars: e.vars, failFast: e.config.FailFast}, priority+1),
+			),
+		)
+	}
+	if e.numbering {
+		// last, once types have been normalized and misplaced admonitions
+		// have become blockquotes
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&numberingTransformer{sectionLevel: e.sectionLevel}, priority+4),
+			),
+		)
+	}
+	if e.restrictions != nil {
+		// after blockquotes have become admonitions and their types have been
+		// normalized, before misplaced ones become blockquotes again
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&restrictedTransformer{restrictions: *e.restrictions}, priority+2),
+			),
+		)
+	}
+	if e.optionsLine {
+		// after blockquotes have become admonitions
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&optionsLineTransformer{}, priority+1),
+			),
+		)
+	}
+	if e.terminators.Unterminated == UnterminatedError {
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&unterminatedTransformer{}, priority),
+			),
+		)
+	}
+	if e.config.FailFast && e.config.Expiry != ExpiryIgnore {
+		// after blockquotes have become admonitions
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&expiresTransformer{}, priority+1),
+			),
+		)
+	}
+	if e.containers != nil {
+		// after every transformer which may still create or change
+		// admonitions, before numbering
+		md.Parser().AddOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(&containersTransformer{allowed: e.containers, report: e.onMisplaced, failFast: e.config.FailFast}, priority+3),
+			),
+		)
+	}
+	r := &Renderer{Config: e.config, markdown: md.Renderer(), abbreviationPattern: abbreviationPattern(e.config.Abbreviations)}
+	if e.config.Target == TargetConfluence {
+		// storage format is XML, void elements have to be closed
+		r.XHTML = true
+		md.Renderer().AddOptions(html.WithXHTML())
+	}
+	if e.checkConflicts || e.config.FailFast {
+		r.checkConflicts = e.checkConflicts
+		r.onConflict = e.onConflict
+		md.Renderer().AddOptions(configCapture{&r.rendererConfig})
+	}
 	md.Renderer().AddOptions(
 		renderer.WithNodeRenderers(
-			util.Prioritized(&Renderer{}, priority),
+			util.Prioritized(r, priority),
 		),
 	)
 }
@@ -0,0 +1,111 @@
+package admonitions
+
+import (
+	"fmt"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// A Problem is an unexpected condition, found by Doctor or while parsing with
+// WithFailFast, e.g. an unknown alert type. Err describes it.
+type Problem struct {
+	Err error
+}
+
+// A ProblemNode struct represents a Problem found while parsing with
+// WithFailFast. Rendering it fails with Err, so the problem surfaces as an
+// error of goldmark's Convert.
+type ProblemNode struct {
+	ast.BaseBlock
+	Problem
+}
+
+// Dump implements Node.Dump .
+func (n *ProblemNode) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Err": n.Err.Error(),
+	}
+	ast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindProblem is a NodeKind of the ProblemNode node.
+var KindProblem = ast.NewNodeKind("Problem")
+
+// Kind implements Node.Kind.
+func (n *ProblemNode) Kind() ast.NodeKind {
+	return KindProblem
+}
+
+// NewProblemNode returns a new ProblemNode node.
+func NewProblemNode(err error) *ProblemNode {
+	return &ProblemNode{Problem: Problem{Err: err}}
+}
+
+// insertProblem inserts a ProblemNode with the message format in front of node,
+// the message starting with the line node starts on
+func insertProblem(node ast.Node, source []byte, format string, args ...interface{}) {
+	node.Parent().InsertBefore(node.Parent(), node, NewProblemNode(problemAt(node, source, format, args...)))
+}
+
+// problemAt returns an error with the message format, starting with the line
+// node starts on
+func problemAt(node ast.Node, source []byte, format string, args ...interface{}) error {
+	line := 0
+	if n, ok := node.(*Admonition); ok && n.Opener.Len() > 0 {
+		line = lineAt(source, n.Opener.Start)
+	} else if start, _, ok := sourceRange(node); ok {
+		line = lineAt(source, start)
+	}
+	return fmt.Errorf("admonitions: line %d: "+format, append([]interface{}{line}, args...)...)
+}
+
+// renderProblem fails with the error of the ProblemNode
+func (r *Renderer) renderProblem(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	return ast.WalkStop, node.(*ProblemNode).Err
+}
+
+// renderedKinds returns the kinds the renderers of config render
+func renderedKinds(config *renderer.Config) map[ast.NodeKind]bool {
+	kinds := map[ast.NodeKind]bool{}
+	for _, v := range config.NodeRenderers {
+		if nr, ok := v.Value.(renderer.NodeRenderer); ok {
+			rec := &kindRecorder{}
+			nr.RegisterFuncs(rec)
+			for _, kind := range rec.kinds {
+				kinds[kind] = true
+			}
+		}
+	}
+	return kinds
+}
+
+// checkAdmonition returns an error if n can't be rendered as written: its
+// segments lie outside of source or it contains nodes no renderer renders,
+// which goldmark would skip silently
+func (r *Renderer) checkAdmonition(n *Admonition, source []byte) error {
+	for _, segment := range []struct {
+		name  string
+		stop  int
+		valid bool
+	}{
+		{"opener", n.Opener.Stop, n.Opener.Start <= n.Opener.Stop},
+		{"body", n.Body.Stop, n.Body.Start <= n.Body.Stop},
+		{"closer", n.Closer.Stop, n.Closer.Start <= n.Closer.Stop},
+	} {
+		if !segment.valid || segment.stop > len(source) {
+			return fmt.Errorf("admonitions: the %s of a %s admonition lies outside of the source", segment.name, n.AdmonitionClass)
+		}
+	}
+
+	if r.renderedKinds == nil {
+		return nil
+	}
+	return ast.Walk(n, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering && !r.renderedKinds[node.Kind()] {
+			return ast.WalkStop, fmt.Errorf("admonitions: no renderer for %s nodes in a %s admonition", node.Kind(), n.AdmonitionClass)
+		}
+		return ast.WalkContinue, nil
+	})
+}
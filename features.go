@@ -0,0 +1,116 @@
+package admonitions
+
+import (
+	"runtime/debug"
+	"sort"
+)
+
+// modulePath is the path this package is required by
+const modulePath = "github.com/PGlesmann/goldmark-admonitions"
+
+// Version returns the version of this module the running binary was built
+// with, e.g. "v1.2.0", or "(devel)" if it is built from a checkout.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath {
+			if dep.Replace != nil {
+				return dep.Replace.Version
+			}
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
+
+// features maps the names returned by Features to whether they are enabled.
+// Every option enables at least one of them.
+var features = map[string]func(e *Extender) bool{
+	"abbreviations":             func(e *Extender) bool { return e.config.Abbreviations != nil },
+	"aliases":                   func(e *Extender) bool { return e.aliases != nil },
+	"approval-footer":           func(e *Extender) bool { return e.config.ApprovalFooter },
+	"blockquotes":               func(e *Extender) bool { return e.convertsBlockQuotes() },
+	"bold-labels":               func(e *Extender) bool { return e.boldLabels },
+	"case-sensitive":            func(e *Extender) bool { return e.caseSensitive },
+	"class-scope":               func(e *Extender) bool { return e.config.ClassScope != "" },
+	"class-strategy":            func(e *Extender) bool { return e.config.ClassStrategy != nil },
+	"classification-scope":      func(e *Extender) bool { return e.classificationScope != ClassifyFirstBlock },
+	"clock":                     func(e *Extender) bool { return e.config.Clock != nil },
+	"collapse":                  func(e *Extender) bool { return e.config.CollapseScript },
+	"compact-threshold":         func(e *Extender) bool { return e.config.CompactThreshold > 0 },
+	"compact-title-only":        func(e *Extender) bool { return e.config.CompactTitleOnly },
+	"component-tag":             func(e *Extender) bool { return e.config.ComponentTag != "" },
+	"conflict-check":            func(e *Extender) bool { return e.checkConflicts },
+	"confluence":                func(e *Extender) bool { return e.config.Target == TargetConfluence },
+	"confluence-diagram-macros": func(e *Extender) bool { return e.config.ConfluenceDiagramMacros != nil },
+	"confluence-icons":          func(e *Extender) bool { return e.config.ConfluenceIcons != nil },
+	"confluence-page":           func(e *Extender) bool { return e.config.ConfluenceOutput == ConfluencePage },
+	"confluence-parameters":     func(e *Extender) bool { return e.config.ConfluenceParameters != nil },
+	"containers":                func(e *Extender) bool { return e.containers != nil },
+	"custom-alerts":             func(e *Extender) bool { return e.customAlerts },
+	"depth-style":               func(e *Extender) bool { return e.config.DepthStyle },
+	"directives":                func(e *Extender) bool { return e.parsesDirectives() },
+	"exact-markers":             func(e *Extender) bool { return e.exactMarkers },
+	"expiry":                    func(e *Extender) bool { return e.config.Expiry != ExpiryIgnore },
+	"fail-fast":                 func(e *Extender) bool { return e.config.FailFast },
+	"figures":                   func(e *Extender) bool { return e.config.Figures },
+	"flags":                     func(e *Extender) bool { return e.config.Flags != nil },
+	"icon-sprite":               func(e *Extender) bool { return e.config.IconSprite },
+	"icons": func(e *Extender) bool {
+		return e.config.Icons != nil || e.config.IconURLs != nil || e.config.IconBaseURL != "" || e.config.IconChains != nil
+	},
+	"inline":                 func(e *Extender) bool { return e.inline },
+	"keywords-anywhere":      func(e *Extender) bool { return e.keywordsAnywhere },
+	"kinds":                  func(e *Extender) bool { return e.config.Kinds != nil },
+	"markers":                func(e *Extender) bool { return e.markers != nil },
+	"metadata":               func(e *Extender) bool { return e.config.Metadata },
+	"mkdocs":                 func(e *Extender) bool { return e.parsesMkDocs() },
+	"no-title-abbreviations": func(e *Extender) bool { return e.config.NoTitleAbbreviations },
+	"numbering":              func(e *Extender) bool { return e.numbering },
+	"options-line":           func(e *Extender) bool { return e.optionsLine },
+	"pandoc":                 func(e *Extender) bool { return e.parsesPandoc() },
+	"priority":               func(e *Extender) bool { return e.priority != 0 },
+	"random-ids":             func(e *Extender) bool { return e.randomIDs },
+	"responsive":             func(e *Extender) bool { return e.config.Responsive },
+	"restricted":             func(e *Extender) bool { return e.restrictions != nil },
+	"rst":                    func(e *Extender) bool { return e.parsesRST() },
+	"screen-reader":          func(e *Extender) bool { return e.config.ScreenReaderLabels },
+	"severity-macros":        func(e *Extender) bool { return e.config.SeverityMacros != nil },
+	"source-map":             func(e *Extender) bool { return e.config.SourceMap },
+	"stats":                  func(e *Extender) bool { return e.config.Stats != nil },
+	"steps":                  func(e *Extender) bool { return e.steps != nil },
+	"strict-markers":         func(e *Extender) bool { return e.strictMarkers },
+	"tabs":                   func(e *Extender) bool { return e.tabs },
+	"terminators":            func(e *Extender) bool { return e.terminators != Terminators{} },
+	"tickets":                func(e *Extender) bool { return len(e.config.TicketLinks) > 0 },
+	"title-element":          func(e *Extender) bool { return e.config.TitleElement != TitleDiv },
+	"title-filter":           func(e *Extender) bool { return e.config.TitleFilter != nil },
+	"title-func":             func(e *Extender) bool { return e.config.TitleFunc != nil },
+	"title-level":            func(e *Extender) bool { return e.config.TitleLevel != 0 },
+	"unsafe":                 func(e *Extender) bool { return e.config.Unsafe },
+	"unwrap":                 func(e *Extender) bool { return e.config.Unwrap },
+	"vars":                   func(e *Extender) bool { return e.vars != nil },
+	"web-component":          func(e *Extender) bool { return e.config.Target == TargetWebComponent },
+	"word-limit":             func(e *Extender) bool { return e.config.WordLimit > 0 },
+}
+
+// Features returns the sorted names of the capabilities enabled by the
+// options of e, e.g. "blockquotes" with WithBlockQuoteAdmonitions or
+// "responsive" with WithResponsive, so host applications can report and gate
+// on them.
+func (e *Extender) Features() []string {
+	enabled := []string{}
+	for name, isEnabled := range features {
+		if isEnabled(e) {
+			enabled = append(enabled, name)
+		}
+	}
+	sort.Strings(enabled)
+	return enabled
+}
@@ -0,0 +1,50 @@
+package admonitions
+
+import (
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// figureImage returns the only content of n if it is an image, possibly
+// linked, e.g. a screenshot callout:
+//
+//	!!!note The settings dialog
+//	![](settings.png)
+//	!!!
+func figureImage(n *Admonition) (ast.Node, bool) {
+	paragraph := n.FirstChild()
+	if paragraph == nil || paragraph.NextSibling() != nil || paragraph.Kind() != ast.KindParagraph {
+		return nil, false
+	}
+	inline := paragraph.FirstChild()
+	if inline == nil || inline.NextSibling() != nil {
+		return nil, false
+	}
+	image := inline
+	if image.Kind() == ast.KindLink && image.ChildCount() == 1 {
+		image = image.FirstChild()
+	}
+	return inline, image.Kind() == ast.KindImage
+}
+
+// renderFigure renders n as a <figure> of its image, with the title as the
+// <figcaption>
+func (r *Renderer) renderFigure(w util.BufWriter, source []byte, n *Admonition, image ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		r.writeAttribution(w, n)
+		r.writeFooter(w, n)
+		_, _ = w.WriteString("</figure>\n")
+		return ast.WalkContinue, nil
+	}
+
+	r.writeWrapperElement(w, source, n, "figure")
+	_, _ = w.WriteString("  ")
+	if err := r.markdown.Render(w, source, image); err != nil {
+		return ast.WalkStop, err
+	}
+	_ = w.WriteByte('\n')
+	if len(r.title(n)) > 0 {
+		r.writeTitle(w, n, "figcaption")
+	}
+	return ast.WalkSkipChildren, nil
+}
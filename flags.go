@@ -0,0 +1,50 @@
+package admonitions
+
+import (
+	"strings"
+)
+
+// ifAttribute makes rendering an admonition depend on flags, see WithFlags
+var ifAttribute = []byte("if")
+
+// Condition returns the value of the if attribute, if any
+func (n *Admonition) Condition() (string, bool) {
+	value, ok := n.Attribute(ifAttribute)
+	if !ok {
+		return "", false
+	}
+	condition, ok := value.([]byte)
+	return string(condition), ok
+}
+
+// evaluateCondition reports whether all comma separated flags of condition
+// are set. Flags prefixed with "!" must not be set.
+func evaluateCondition(condition string, flags map[string]bool) bool {
+	for _, flag := range strings.Split(condition, ",") {
+		flag = strings.TrimSpace(flag)
+		if flag == "" {
+			continue
+		}
+		if strings.HasPrefix(flag, "!") {
+			if flags[strings.TrimSpace(flag[1:])] {
+				return false
+			}
+		} else if !flags[flag] {
+			return false
+		}
+	}
+	return true
+}
+
+// isHidden reports whether the condition of n rules out rendering it, or it
+// expired with ExpiryHide
+func (r *Renderer) isHidden(n *Admonition) bool {
+	if r.Expiry == ExpiryHide && r.isExpired(n) {
+		return true
+	}
+	if r.Flags == nil {
+		return false
+	}
+	condition, ok := n.Condition()
+	return ok && !evaluateCondition(condition, r.Flags)
+}
@@ -0,0 +1,42 @@
+package admonitions
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/yuin/goldmark"
+)
+
+// A Fragment is a part of a document assembled from several Markdown sources,
+// e.g. vendored docs written for MkDocs within a site using GitHub alerts
+type Fragment struct {
+	Name    string // identifies the fragment in errors, e.g. its path
+	Source  []byte
+	Options []Option // the options of the fragment, applied after the shared ones
+}
+
+// ConvertFragments converts fragments one after the other into a single
+// document written to w. Each fragment is parsed on its own, with an Extender
+// configured by opts followed by the options of the fragment, so syntax
+// doesn't carry over from one fragment into the next.
+func ConvertFragments(w io.Writer, fragments []Fragment, opts ...Option) error {
+	return ConvertFragmentsWith(w, fragments, func(e *Extender) goldmark.Markdown {
+		return goldmark.New(goldmark.WithExtensions(e))
+	}, opts...)
+}
+
+// ConvertFragmentsWith is ConvertFragments with the goldmark instance of every
+// fragment created by newMarkdown from its Extender, which lets you add other
+// extensions. The first error stops the conversion and is returned along with
+// the name of the fragment.
+func ConvertFragmentsWith(w io.Writer, fragments []Fragment, newMarkdown func(*Extender) goldmark.Markdown, opts ...Option) error {
+	for _, f := range fragments {
+		all := make([]Option, 0, len(opts)+len(f.Options))
+		all = append(append(all, opts...), f.Options...)
+
+		if err := newMarkdown(New(all...)).Convert(f.Source, w); err != nil {
+			return fmt.Errorf("admonitions: fragment %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
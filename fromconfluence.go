@@ -0,0 +1,335 @@
+package admonitions
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// storageSpace matches the whitespace collapsed in inline text
+var storageSpace = regexp.MustCompile(`\s+`)
+
+// confluenceAlerts maps Confluence macros back to the GitHub alert types
+// rendered as them by confluenceMacros. Of the types sharing a macro the
+// first one wins, CAUTION becomes WARNING.
+var confluenceAlerts = func() map[string]string {
+	alerts := map[string]string{}
+	for _, alert := range []string{"NOTE", "TIP", "IMPORTANT", "WARNING", "CAUTION"} {
+		macro := confluenceMacros[strings.ToLower(alert)]
+		if _, ok := alerts[macro]; !ok {
+			alerts[macro] = alert
+		}
+	}
+	return alerts
+}()
+
+// A storageNode is an element or, without a name, a text of Confluence storage
+// format
+type storageNode struct {
+	name     string // the element name with its ac: or ri: prefix
+	attrs    map[string]string
+	children []*storageNode
+	text     string
+}
+
+// FromConfluence converts Confluence storage format back to Markdown, e.g. for
+// tools syncing pages in both directions. info, tip, note and warning macros
+// become the GitHub alerts rendered as them, important, tip, note and warning,
+// a title becomes the bold first line of the alert:
+//
+//	> [!WARNING]
+//	> **Careful**
+//	>
+//	> Don't do *this*.
+//
+// Paragraphs, headings, lists including nested ones, code and the common
+// inline elements are converted as well, other elements are reduced to their
+// text. Markdown syntax within texts is escaped, so "# x" stays a paragraph.
+// Both bare fragments and pages declaring the namespaces are accepted.
+func FromConfluence(storage []byte) ([]byte, error) {
+	root, err := parseStorage(storage)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	writeStorageBlocks(&buf, root.children)
+	return append(bytes.TrimSpace(buf.Bytes()), '\n'), nil
+}
+
+// parseStorage parses storage into a tree below an unnamed root
+func parseStorage(storage []byte) (*storageNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(storage))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	root := &storageNode{}
+	stack := []*storageNode{root}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return root, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &storageNode{name: storageName(t.Name), attrs: map[string]string{}}
+			for _, attr := range t.Attr {
+				node.attrs[storageName(attr.Name)] = attr.Value
+			}
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.children = append(parent.children, &storageNode{text: string(t)})
+		}
+	}
+}
+
+// storageName returns name with the prefix storage format uses, whether or
+// not its namespace has been declared
+func storageName(name xml.Name) string {
+	switch name.Space {
+	case "ac", confluenceACNamespace:
+		return "ac:" + name.Local
+	case "ri", confluenceRINamespace:
+		return "ri:" + name.Local
+	}
+	return name.Local
+}
+
+// parameter returns the text of the ac:parameter named name of a macro
+func (n *storageNode) parameter(name string) string {
+	for _, child := range n.children {
+		if child.name == "ac:parameter" && child.attrs["ac:name"] == name {
+			return child.textContent()
+		}
+	}
+	return ""
+}
+
+// child returns the first child element named name
+func (n *storageNode) child(name string) *storageNode {
+	for _, child := range n.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+func (n *storageNode) textContent() string {
+	if n.name == "" {
+		return n.text
+	}
+	var b strings.Builder
+	for _, child := range n.children {
+		b.WriteString(child.textContent())
+	}
+	return b.String()
+}
+
+// writeStorageBlocks writes nodes as Markdown blocks, each followed by a
+// blank line
+func writeStorageBlocks(buf *bytes.Buffer, nodes []*storageNode) {
+	var inline []*storageNode
+	flush := func() {
+		if text := strings.TrimSpace(storageInline(inline)); text != "" {
+			buf.WriteString(text)
+			buf.WriteString("\n\n")
+		}
+		inline = nil
+	}
+
+	for _, n := range nodes {
+		switch n.name {
+		case "p":
+			flush()
+			inline = n.children
+			flush()
+		case "h1", "h2", "h3", "h4", "h5", "h6":
+			flush()
+			buf.WriteString(strings.Repeat("#", int(n.name[1]-'0')) + " ")
+			buf.WriteString(strings.TrimSpace(storageInline(n.children)))
+			buf.WriteString("\n\n")
+		case "ul", "ol":
+			flush()
+			writeStorageList(buf, n, "")
+		case "pre":
+			flush()
+			writeStorageCode(buf, "", n.textContent())
+		case "ac:structured-macro":
+			flush()
+			writeStorageMacro(buf, n)
+		case "ac:confluence", "div", "ac:rich-text-body":
+			flush()
+			writeStorageBlocks(buf, n.children)
+		default:
+			inline = append(inline, n)
+		}
+	}
+	flush()
+}
+
+// writeStorageList writes list as a Markdown list, its lines after the first
+// one indented by indent. Nested lists are indented to the content of their
+// item.
+func writeStorageList(buf *bytes.Buffer, list *storageNode, indent string) {
+	i := 0
+	for _, item := range list.children {
+		if item.name != "li" {
+			continue
+		}
+		i++
+		marker := "- "
+		if list.name == "ol" {
+			marker = strconv.Itoa(i) + ". "
+		}
+		if i > 1 {
+			buf.WriteString(indent)
+		}
+		buf.WriteString(marker)
+
+		nested := indent + strings.Repeat(" ", len(marker))
+		lineStart := false // whether the marker or a nested list precedes
+		var inline []*storageNode
+		flush := func() {
+			if text := strings.TrimSpace(storageInline(inline)); text != "" {
+				if lineStart {
+					buf.WriteString(nested)
+				}
+				buf.WriteString(strings.ReplaceAll(text, "\n", "\n"+nested))
+				buf.WriteString("\n")
+				lineStart = true
+			}
+			inline = nil
+		}
+		for _, child := range item.children {
+			if child.name != "ul" && child.name != "ol" {
+				inline = append(inline, child)
+				continue
+			}
+			flush()
+			if !lineStart {
+				buf.WriteString("\n")
+				lineStart = true
+			}
+			buf.WriteString(nested)
+			writeStorageList(buf, child, nested)
+		}
+		flush()
+		if !lineStart {
+			buf.WriteString("\n")
+		}
+	}
+	if indent == "" {
+		buf.WriteString("\n")
+	}
+}
+
+func writeStorageCode(buf *bytes.Buffer, language, code string) {
+	buf.WriteString("```" + language + "\n")
+	buf.WriteString(strings.TrimRight(code, "\n"))
+	buf.WriteString("\n```\n\n")
+}
+
+// writeStorageMacro writes a macro as a GitHub alert, a code block or, if it
+// is neither, its body
+func writeStorageMacro(buf *bytes.Buffer, macro *storageNode) {
+	name := macro.attrs["ac:name"]
+	if name == "code" {
+		if body := macro.child("ac:plain-text-body"); body != nil {
+			writeStorageCode(buf, macro.parameter("language"), body.textContent())
+		}
+		return
+	}
+
+	body := macro.child("ac:rich-text-body")
+	alert, ok := confluenceAlerts[name]
+	if !ok {
+		if body != nil {
+			writeStorageBlocks(buf, body.children)
+		}
+		return
+	}
+
+	var content bytes.Buffer
+	if title := strings.TrimSpace(macro.parameter("title")); title != "" {
+		content.WriteString("**" + escapeStorageText(title, false) + "**\n\n")
+	}
+	if body != nil {
+		writeStorageBlocks(&content, body.children)
+	}
+
+	buf.WriteString("> [!" + alert + "]\n")
+	for _, line := range strings.Split(strings.TrimSpace(content.String()), "\n") {
+		if line == "" {
+			buf.WriteString(">\n")
+		} else {
+			buf.WriteString("> " + line + "\n")
+		}
+	}
+	buf.WriteString("\n")
+}
+
+// storageInline returns nodes as inline Markdown with whitespace collapsed
+func storageInline(nodes []*storageNode) string {
+	var b strings.Builder
+	writeStorageInline(&b, nodes)
+	return b.String()
+}
+
+func writeStorageInline(b *strings.Builder, nodes []*storageNode) {
+	for _, n := range nodes {
+		switch n.name {
+		case "":
+			text := storageSpace.ReplaceAllString(n.text, " ")
+			lineStart := b.Len() == 0 || strings.HasSuffix(b.String(), "\n")
+			if lineStart {
+				text = strings.TrimLeft(text, " ")
+			}
+			b.WriteString(escapeStorageText(text, lineStart))
+		case "strong", "b":
+			b.WriteString("**" + strings.TrimSpace(storageInline(n.children)) + "**")
+		case "em", "i":
+			b.WriteString("*" + strings.TrimSpace(storageInline(n.children)) + "*")
+		case "code":
+			b.WriteString("`" + n.textContent() + "`")
+		case "a":
+			b.WriteString("[" + strings.TrimSpace(storageInline(n.children)) + "](" + n.attrs["href"] + ")")
+		case "br":
+			b.WriteString("\\\n")
+		case "ac:parameter":
+		default:
+			writeStorageInline(b, n.children)
+		}
+	}
+}
+
+// storageEscaped matches the characters of texts that would be Markdown
+// syntax anywhere in a line
+var storageEscaped = regexp.MustCompile("[\\\\*_`\\[\\]<]")
+
+// storageLineStart matches what would start a heading, blockquote, list,
+// thematic break or fence at the start of a line
+var storageLineStart = regexp.MustCompile(`^(?:[#>+=~-]|[0-9]+[.)])`)
+
+// escapeStorageText escapes the Markdown syntax within text, which starts a
+// line if lineStart is set, so it reads as the same text
+func escapeStorageText(text string, lineStart bool) string {
+	text = storageEscaped.ReplaceAllString(text, `\$0`)
+	if m := storageLineStart.FindStringIndex(text); m != nil && lineStart {
+		text = text[:m[1]-1] + `\` + text[m[1]-1:]
+	}
+	return text
+}
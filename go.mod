@@ -2,4 +2,7 @@ module github.com/PGlesmann/goldmark-admonitions
 
 go 1.19
 
-require github.com/yuin/goldmark v1.7.8
+require (
+	github.com/yuin/goldmark v1.7.8
+	gopkg.in/yaml.v3 v3.0.1
+)
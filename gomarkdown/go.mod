@@ -0,0 +1,14 @@
+module github.com/PGlesmann/goldmark-admonitions/gomarkdown
+
+go 1.19
+
+require (
+	github.com/PGlesmann/goldmark-admonitions v0.0.0
+	github.com/gomarkdown/markdown v0.0.0-20260923180740-94fc73f6b1a3
+	github.com/yuin/goldmark v1.7.8
+)
+
+require gopkg.in/yaml.v3 v3.0.1 // indirect
+
+// the admonitions package of this repository
+replace github.com/PGlesmann/goldmark-admonitions => ../
@@ -0,0 +1,147 @@
+// Package gomarkdown converts documents parsed by
+// github.com/gomarkdown/markdown into goldmark documents with admonitions. It
+// is a module of its own, so the admonitions package doesn't depend on
+// gomarkdown.
+package gomarkdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/gomarkdown/markdown"
+	gmast "github.com/gomarkdown/markdown/ast"
+	gmhtml "github.com/gomarkdown/markdown/html"
+	"github.com/yuin/goldmark/ast"
+)
+
+// alertMarker matches a GitHub alert marker like "[!NOTE] Title" at the
+// start of a block quote. The first group is the type, the second one the
+// (optional) title.
+var alertMarker = regexp.MustCompile(`(?i)^\[!([a-z]+)\][ \t]*([^\n]*)\n?`)
+
+// An Option configures Convert and Parse
+type Option func(*converter)
+
+// WithCustomAlerts converts block quotes with alert markers of other types
+// than the GitHub ones as well, like admonitions.WithCustomAlerts, e.g.
+// "> [!BUG]" to an admonition of the class "bug"
+func WithCustomAlerts() Option {
+	return func(c *converter) {
+		c.customAlerts = true
+	}
+}
+
+// converter converts the nodes of a gomarkdown document
+type converter struct {
+	renderer     markdown.Renderer
+	customAlerts bool
+}
+
+// Convert converts a document parsed by github.com/gomarkdown/markdown into a
+// goldmark document, so projects migrating from gomarkdown can keep rendering
+// their callouts with the admonitions package.
+//
+// Block quotes (and mmark asides) whose first line is a GitHub alert marker
+// become Admonition nodes, classified like by the admonitions extension:
+//
+//	> [!NOTE] This is the title
+//	> This is the admonition
+//
+// Everything else is rendered to HTML by gomarkdown and kept verbatim. The
+// returned document doesn't reference any source, so render it with a nil
+// source.
+func Convert(doc gmast.Node, opts ...Option) *ast.Document {
+	c := &converter{renderer: gmhtml.NewRenderer(gmhtml.RendererOptions{Flags: gmhtml.CommonFlags})}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	document := ast.NewDocument()
+	c.convertChildren(document, doc.GetChildren(), 0)
+
+	return document
+}
+
+// Parse parses source with gomarkdown's common extensions and converts the
+// result with Convert.
+func Parse(source []byte, opts ...Option) *ast.Document {
+	return Convert(markdown.Parse(source, nil), opts...)
+}
+
+func (c *converter) convertChildren(parent ast.Node, children []gmast.Node, level int) {
+	for _, child := range children {
+		if node := c.convertAdmonition(child, level); node != nil {
+			parent.AppendChild(parent, node)
+			continue
+		}
+
+		// gomarkdown has already escaped the output, so it's written as is.
+		// Leading newlines only separate blocks in gomarkdown's own output.
+		html := ast.NewString(bytes.TrimLeft(markdown.Render(child, c.renderer), "\n"))
+		html.SetCode(true)
+		parent.AppendChild(parent, html)
+	}
+}
+
+// convertAdmonition returns nil if node isn't an alert
+func (c *converter) convertAdmonition(node gmast.Node, level int) *admonitions.Admonition {
+	switch node.(type) {
+	case *gmast.BlockQuote, *gmast.Aside:
+	default:
+		return nil
+	}
+
+	children := node.GetChildren()
+	if len(children) == 0 {
+		return nil
+	}
+	paragraph, ok := children[0].(*gmast.Paragraph)
+	if !ok || len(paragraph.Children) == 0 {
+		return nil
+	}
+	text, ok := paragraph.Children[0].(*gmast.Text)
+	if !ok {
+		return nil
+	}
+	match := alertMarker.FindSubmatchIndex(text.Literal)
+	if match == nil {
+		return nil
+	}
+
+	// the same types as for blockquotes, see admonitions.WithCustomAlerts
+	alertType := text.Literal[match[2]:match[3]]
+	bqType := admonitions.GHAlertsBlockQuoteClassifier().ClassifyingBlockQuote("!" + string(alertType))
+	class := []byte(bqType.String())
+	if bqType == admonitions.None {
+		if !c.customAlerts || bytes.EqualFold(alertType, []byte("end")) {
+			return nil
+		}
+		class = bytes.ToLower(alertType)
+	}
+
+	admonition := admonitions.NewAdmonition()
+	admonition.AdmonitionClass = class
+	admonition.AlertType = append([]byte(nil), alertType...)
+	if title := bytes.TrimSpace(text.Literal[match[4]:match[5]]); len(title) > 0 {
+		admonition.Title = title
+	}
+	admonition.SetAttributeString("class", append([]byte("admonition adm-"), class...))
+	admonition.SetAttributeString("data-admonition", []byte(fmt.Sprint(level)))
+
+	// ========================================================================== //
+	// 	Strip the marker from the body without modifying the gomarkdown tree
+
+	literal := text.Literal
+	text.Literal = literal[match[1]:]
+	defer func() { text.Literal = literal }()
+
+	if len(text.Literal) == 0 && len(paragraph.Children) == 1 {
+		children = children[1:]
+	}
+
+	c.convertChildren(admonition, children, level+1)
+
+	return admonition
+}
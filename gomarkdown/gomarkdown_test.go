@@ -0,0 +1,82 @@
+package gomarkdown_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/PGlesmann/goldmark-admonitions/gomarkdown"
+	"github.com/yuin/goldmark"
+)
+
+func ExampleParse() {
+	src := []byte(`
+## Hello
+
+> [!NOTE] This is a note
+> The body
+>
+> > [!warning]
+> > Nested without a title
+
+A paragraph
+
+> Just a quote
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	doc := gomarkdown.Parse(src)
+	markdown.Renderer().Render(os.Stdout, nil, doc)
+
+	// Output:
+	// <h2>Hello</h2>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">This is a note</div>
+	//   <div class="adm-body">
+	// <p>The body</p>
+	// <div class="admonition adm-warning" data-admonition="1">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Nested without a title</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+	// <p>A paragraph</p>
+	// <blockquote>
+	// <p>Just a quote</p>
+	// </blockquote>
+}
+
+func ExampleWithCustomAlerts() {
+	src := []byte(`
+> [!BUG] Known issue
+> Crashes on start.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	// like blockquotes, unknown types are only alerts with WithCustomAlerts
+	markdown.Renderer().Render(os.Stdout, nil, gomarkdown.Parse(src))
+	markdown.Renderer().Render(os.Stdout, nil, gomarkdown.Parse(src, gomarkdown.WithCustomAlerts()))
+
+	// Output:
+	// <blockquote>
+	// <p>[!BUG] Known issue
+	// Crashes on start.</p>
+	// </blockquote>
+	// <div class="admonition adm-bug" data-admonition="0">
+	//   <div class="adm-title">Known issue</div>
+	//   <div class="adm-body">
+	// <p>Crashes on start.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,101 @@
+package admonitions
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// Hash returns a hex encoded SHA-256 hash of the class, the title and the
+// plain text of the body, including the destinations of links and images and
+// the titles of nested admonitions. Whitespace is normalized and markup is
+// ignored, so the hash only changes if the wording or a link does, e.g. to let
+// CI require a new review whenever a security warning is reworded.
+func (n *Admonition) Hash(source []byte) string {
+	var buf bytes.Buffer
+	buf.Write(n.AdmonitionClass)
+	buf.WriteByte(0)
+	buf.Write(normalizeSpace(n.Title))
+	buf.WriteByte(0)
+	buf.Write(normalizeSpace(plainTextWith(n, source, hashedText)))
+
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:])
+}
+
+// hashedText writes what Hash takes into account besides the text of child,
+// the destinations of links and images and the titles of admonitions
+func hashedText(buf *bytes.Buffer, child ast.Node, source []byte) {
+	switch c := child.(type) {
+	case *ast.Link:
+		buf.WriteByte(' ')
+		buf.Write(c.Destination)
+		buf.WriteByte(' ')
+	case *ast.Image:
+		buf.WriteByte(' ')
+		buf.Write(c.Destination)
+		buf.WriteByte(' ')
+	case *ast.AutoLink:
+		buf.WriteByte(' ')
+		buf.Write(c.URL(source))
+		buf.WriteByte(' ')
+	case *Admonition:
+		buf.WriteByte(' ')
+		buf.Write(c.AdmonitionClass)
+		buf.WriteByte(' ')
+		buf.Write(c.Title)
+		buf.WriteByte(' ')
+	}
+}
+
+// plainText returns the text of all descendants of node. Blocks and lines
+// are separated by spaces.
+func plainText(node ast.Node, source []byte) []byte {
+	return plainTextWith(node, source, nil)
+}
+
+// plainTextWith is plainText, but extra, if not nil, writes more of every
+// descendant before its text
+func plainTextWith(node ast.Node, source []byte, extra func(buf *bytes.Buffer, child ast.Node, source []byte)) []byte {
+	var buf bytes.Buffer
+	_ = ast.Walk(node, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || child == node {
+			return ast.WalkContinue, nil
+		}
+		if extra != nil {
+			extra(&buf, child, source)
+		}
+		switch c := child.(type) {
+		case *ast.Text:
+			buf.Write(c.Segment.Value(source))
+			if c.SoftLineBreak() || c.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(c.Value)
+		default:
+			if child.Type() != ast.TypeBlock {
+				break
+			}
+			buf.WriteByte(' ')
+			// code and HTML blocks keep their content in lines
+			if child.FirstChild() == nil {
+				lines := child.Lines()
+				for i := 0; i < lines.Len(); i++ {
+					line := lines.At(i)
+					buf.Write(line.Value(source))
+					buf.WriteByte(' ')
+				}
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return buf.Bytes()
+}
+
+// normalizeSpace collapses all whitespace to single spaces and trims it
+func normalizeSpace(b []byte) []byte {
+	return bytes.Join(bytes.Fields(b), []byte{' '})
+}
@@ -0,0 +1,94 @@
+package admonitions
+
+import (
+	"os"
+	"strings"
+
+	"github.com/yuin/goldmark/util"
+)
+
+// iconifyURL is where Iconify icons are loaded from, followed by the prefix
+// and the name of the icon, e.g. "mdi/rocket.svg"
+const iconifyURL = "https://api.iconify.design/"
+
+// An IconChain lists the icons of a class from the preferred one to the last
+// resort, the first available one is rendered:
+//
+//	admonitions.IconChain{File: "/icons/note.svg", Iconify: "mdi:information", Unicode: "ℹ"}
+//
+// Empty steps are skipped, without any available one the admonition has no
+// icon from its chain.
+type IconChain struct {
+	File    string `json:"file"`    // the path or URL of an image file
+	Iconify string `json:"iconify"` // the name of an Iconify icon, "prefix:name"
+	Unicode string `json:"unicode"` // a symbol rendered as text, always available
+}
+
+// iconifySource returns the URL of the Iconify icon name, false if name
+// isn't "prefix:name"
+func iconifySource(name string) (string, bool) {
+	prefix, icon, ok := strings.Cut(name, ":")
+	if !ok || prefix == "" || icon == "" {
+		return "", false
+	}
+	return iconifyURL + prefix + "/" + icon + ".svg", true
+}
+
+// iconAvailable reports whether the image at src can be rendered, asking
+// IconAvailable if set. Local files have to exist then, URLs are assumed to
+// load.
+func (r *Renderer) iconAvailable(src string) bool {
+	if r.IconAvailable != nil {
+		return r.IconAvailable(src)
+	}
+	if strings.Contains(src, "://") {
+		return true
+	}
+	if available, ok := r.availableIcons.Load(src); ok {
+		return available.(bool)
+	}
+	_, err := os.Stat(src)
+	r.availableIcons.Store(src, err == nil)
+	return err == nil
+}
+
+// chainIcon resolves the IconChain of class, classes inheriting from others
+// falling back to their chains. It returns the source of the image to render
+// or the symbol, and false if there is neither.
+func (r *Renderer) chainIcon(class string) (src, symbol string, ok bool) {
+	for _, c := range kindChain(r.Kinds, class) {
+		chain, found := r.IconChains[c]
+		if !found {
+			continue
+		}
+		if chain.File != "" && r.iconAvailable(chain.File) {
+			return chain.File, "", true
+		}
+		if url, valid := iconifySource(chain.Iconify); valid && r.iconAvailable(url) {
+			return url, "", true
+		}
+		if chain.Unicode != "" {
+			return "", chain.Unicode, true
+		}
+		return "", "", false
+	}
+	return "", "", false
+}
+
+// writeImageIcon writes the icon loaded from src
+func (r *Renderer) writeImageIcon(w util.BufWriter, n *Admonition, src string) {
+	_, _ = w.WriteString(`<img` + r.classAttribute("adm-icon", string(n.AdmonitionClass)) + ` loading="lazy" alt="" src="`)
+	_, _ = w.Write(util.EscapeHTML(util.URLEscape([]byte(src), false)))
+	if r.XHTML {
+		_, _ = w.WriteString(`" />`)
+	} else {
+		_, _ = w.WriteString(`">`)
+	}
+}
+
+// writeSymbolIcon writes the icon that is the text symbol
+func (r *Renderer) writeSymbolIcon(w util.BufWriter, n *Admonition, symbol string) {
+	_, _ = w.WriteString(`<span` + r.classAttribute("adm-icon", string(n.AdmonitionClass)) + ` aria-hidden="true">`)
+	_, _ = w.Write(util.EscapeHTML([]byte(symbol)))
+	_, _ = w.WriteString(`</span>`)
+}
@@ -0,0 +1,163 @@
+package admonitions
+
+import (
+	"sort"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// An Icon is an SVG icon rendered in front of the title of an admonition
+type Icon struct {
+	ViewBox string // the viewBox of the <svg> element, e.g. "0 0 24 24"
+	Content string // the markup inside of the <svg> element
+}
+
+// DefaultIcons are icons for the most common admonition classes. Pass them to
+// WithIcons, possibly extended by your own.
+var DefaultIcons = map[string]Icon{
+	"note": {
+		ViewBox: "0 0 24 24",
+		Content: `<path d="M5 3h10l4 4v14H5z" fill="none" stroke="currentColor" stroke-width="2"/><path d="M8 11h8v2H8zm0 4h8v2H8z"/>`,
+	},
+	"info": {
+		ViewBox: "0 0 24 24",
+		Content: `<circle cx="12" cy="12" r="10" fill="none" stroke="currentColor" stroke-width="2"/><path d="M11 10h2v7h-2zm0-4h2v2h-2z"/>`,
+	},
+	"tip": {
+		ViewBox: "0 0 24 24",
+		Content: `<path d="M12 2a7 7 0 0 0-4 12.7V18h8v-3.3A7 7 0 0 0 12 2zM9 20h6v2H9z"/>`,
+	},
+	"warning": {
+		ViewBox: "0 0 24 24",
+		Content: `<path fill-rule="evenodd" d="M12 2 1 21h22zm-1 7h2v6h-2zm0 8h2v2h-2z"/>`,
+	},
+	"important": {
+		ViewBox: "0 0 24 24",
+		Content: `<path d="M3 3h18v14H8l-5 4z" fill="none" stroke="currentColor" stroke-width="2"/><path d="M11 6h2v6h-2zm0 7h2v2h-2z"/>`,
+	},
+	"caution": {
+		ViewBox: "0 0 24 24",
+		Content: `<path fill-rule="evenodd" d="M7.9 2h8.2L22 7.9v8.2L16.1 22H7.9L2 16.1V7.9zM11 7h2v6h-2zm0 8h2v2h-2z"/>`,
+	},
+	"danger": {
+		ViewBox: "0 0 24 24",
+		Content: `<path fill-rule="evenodd" d="M7.9 2h8.2L22 7.9v8.2L16.1 22H7.9L2 16.1V7.9zM11 7h2v6h-2zm0 8h2v2h-2z"/>`,
+	},
+	"shortcut": {
+		ViewBox: "0 0 24 24",
+		Content: `<rect x="2" y="6" width="20" height="12" rx="2" fill="none" stroke="currentColor" stroke-width="2"/><path d="M5 9h2v2H5zm4 0h2v2H9zm4 0h2v2h-2zm4 0h2v2h-2zM7 13h10v2H7z"/>`,
+	},
+}
+
+// iconID returns the id of the sprite symbol of the icon for class
+func iconID(class string) string {
+	return "adm-icon-" + class
+}
+
+// iconURL returns the URL of the external icon for class, if any. Classes
+// inheriting from others fall back to their icons, see Kind.
+func (r *Renderer) iconURL(class string) (string, bool) {
+	chain := kindChain(r.Kinds, class)
+	for _, c := range chain {
+		if url, ok := r.IconURLs[c]; ok {
+			return url, true
+		}
+	}
+	if r.IconBaseURL != "" && class != "" {
+		return r.IconBaseURL + chain[len(chain)-1] + ".svg", true
+	}
+	return "", false
+}
+
+// iconClass returns the class whose icon in Icons is used for class
+func (r *Renderer) iconClass(class string) (string, bool) {
+	for _, c := range kindChain(r.Kinds, class) {
+		if _, ok := r.Icons[c]; ok {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// writeIcon writes the icon of n, if there is one for its class or the icon
+// of its options line. IconChains take precedence over IconURLs and Icons.
+func (r *Renderer) writeIcon(w util.BufWriter, n *Admonition) {
+	class := n.iconName()
+
+	if src, symbol, ok := r.chainIcon(class); ok {
+		if symbol != "" {
+			r.writeSymbolIcon(w, n, symbol)
+		} else {
+			r.writeImageIcon(w, n, src)
+		}
+		return
+	}
+	if url, ok := r.iconURL(class); ok {
+		r.writeImageIcon(w, n, url)
+		return
+	}
+
+	class, ok := r.iconClass(class)
+	if !ok {
+		return
+	}
+	icon := r.Icons[class]
+
+	if r.IconSprite {
+		r.writeSprite(w, n)
+		_, _ = w.WriteString(`<svg` + r.classAttribute("adm-icon", string(n.AdmonitionClass)) + ` aria-hidden="true"><use href="#`)
+		_, _ = w.Write(util.EscapeHTML([]byte(iconID(class))))
+		_, _ = w.WriteString(`"></use></svg>`)
+		return
+	}
+
+	_, _ = w.WriteString(`<svg` + r.classAttribute("adm-icon", string(n.AdmonitionClass)) + ` aria-hidden="true" xmlns="http://www.w3.org/2000/svg" fill="currentColor" viewBox="`)
+	_, _ = w.Write(util.EscapeHTML([]byte(icon.ViewBox)))
+	_, _ = w.WriteString(`">`)
+	_, _ = w.WriteString(icon.Content)
+	_, _ = w.WriteString(`</svg>`)
+}
+
+// writeSprite writes a hidden SVG with a symbol for every icon used in the
+// document of n. It's only written once per document, right in front of the
+// first icon.
+func (r *Renderer) writeSprite(w util.BufWriter, n *Admonition) {
+	state := r.state(n)
+	if state.sprite {
+		return
+	}
+	state.sprite = true
+	root := documentRoot(n)
+
+	used := map[string]bool{}
+	_ = ast.Walk(root, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if a, ok := node.(*Admonition); ok && entering {
+			if _, _, chained := r.chainIcon(a.iconName()); chained {
+				return ast.WalkContinue, nil
+			}
+			if class, ok := r.iconClass(a.iconName()); ok {
+				used[class] = true
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	classes := make([]string, 0, len(used))
+	for class := range used {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+
+	_, _ = w.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" fill="currentColor" style="display: none">`)
+	for _, class := range classes {
+		icon := r.Icons[class]
+		_, _ = w.WriteString(`<symbol id="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(iconID(class))))
+		_, _ = w.WriteString(`" viewBox="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(icon.ViewBox)))
+		_, _ = w.WriteString(`">`)
+		_, _ = w.WriteString(icon.Content)
+		_, _ = w.WriteString(`</symbol>`)
+	}
+	_, _ = w.WriteString(`</svg>`)
+}
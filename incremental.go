@@ -0,0 +1,93 @@
+package admonitions
+
+import (
+	"github.com/yuin/goldmark/ast"
+)
+
+// An Edit describes a change of a source: the bytes [Start, Stop) of the old
+// source were replaced by NewLength bytes
+type Edit struct {
+	Start     int
+	Stop      int
+	NewLength int
+}
+
+// Reclassify returns the classification of doc, the document parsed from the
+// source after edit was applied to the source m was computed for. Only
+// blockquotes touching the edit are classified again. All others are matched
+// up with their previous classification by their shifted source position.
+//
+// This is meant for language servers and live previews that reparse on every
+// keystroke.
+func (m BlockQuoteTypeMap) Reclassify(doc ast.Node, source []byte, edit Edit) BlockQuoteTypeMap {
+	return m.ReclassifyWithin(doc, source, edit, ClassifyFirstBlock)
+}
+
+// ReclassifyWithin is Reclassify for documents parsed with
+// WithClassificationScope(scope), classifying the blockquotes touching the
+// edit within the same scope.
+func (m BlockQuoteTypeMap) ReclassifyWithin(doc ast.Node, source []byte, edit Edit, scope ClassificationScope) BlockQuoteTypeMap {
+	previous := make(map[int]BlockQuoteType, len(m))
+	for node, t := range m {
+		if start, _, ok := sourceRange(node); ok {
+			previous[start] = t
+		}
+	}
+
+	editStop := edit.Start + edit.NewLength
+	shift := edit.NewLength - (edit.Stop - edit.Start)
+
+	types := make(BlockQuoteTypeMap)
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if node.Kind() != ast.KindBlockquote || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		if start, stop, ok := sourceRange(node); ok && (stop < edit.Start || start > editStop) {
+			oldStart := start
+			if start > editStop {
+				oldStart -= shift
+			}
+			if t, found := previous[oldStart]; found {
+				types[node] = t
+				return ast.WalkContinue, nil
+			}
+		}
+
+		types[node] = classifyBlockQuote(node, source, scope)
+		return ast.WalkContinue, nil
+	})
+
+	return types
+}
+
+// sourceRange returns the range of source the descendants of node span
+func sourceRange(node ast.Node) (start, stop int, ok bool) {
+	extend := func(segmentStart, segmentStop int) {
+		if !ok || segmentStart < start {
+			start = segmentStart
+		}
+		if !ok || segmentStop > stop {
+			stop = segmentStop
+		}
+		ok = true
+	}
+
+	_ = ast.Walk(node, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if text, isText := child.(*ast.Text); isText {
+			extend(text.Segment.Start, text.Segment.Stop)
+		} else if child.Type() == ast.TypeBlock {
+			lines := child.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				extend(line.Start, line.Stop)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	return start, stop, ok
+}
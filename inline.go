@@ -0,0 +1,92 @@
+package admonitions
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// An InlineAdmonition struct represents a short callout within a sentence,
+// e.g. "[!tip: remember to save]". Its children are the text.
+type InlineAdmonition struct {
+	ast.BaseInline
+	AdmonitionClass []byte
+}
+
+// Dump implements Node.Dump .
+func (n *InlineAdmonition) Dump(source []byte, level int) {
+	m := map[string]string{
+		"AdmonitionClass": string(n.AdmonitionClass),
+	}
+	ast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindInlineAdmonition is a NodeKind of the InlineAdmonition node.
+var KindInlineAdmonition = ast.NewNodeKind("InlineAdmonition")
+
+// Kind implements Node.Kind.
+func (n *InlineAdmonition) Kind() ast.NodeKind {
+	return KindInlineAdmonition
+}
+
+// NewInlineAdmonition returns a new InlineAdmonition node.
+func NewInlineAdmonition(class []byte) *InlineAdmonition {
+	return &InlineAdmonition{AdmonitionClass: class}
+}
+
+// inlineOpener matches an inline admonition at the start of the rest of a
+// line, the class in the first group and the text in the second
+var inlineOpener = regexp.MustCompile(`^\[!([A-Za-z][\w-]*):[ \t]*([^\]\n]*[^\]\s])[ \t]*\]`)
+
+// inlineParser parses inline admonitions, see WithInlineAdmonitions
+type inlineParser struct{}
+
+// Trigger implements parser.InlineParser.Trigger .
+func (p *inlineParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+// Parse implements parser.InlineParser.Parse .
+func (p *inlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+	match := inlineOpener.FindSubmatchIndex(line)
+	if match == nil {
+		return nil
+	}
+	if rest := line[match[1]:]; len(rest) > 0 && (rest[0] == '(' || rest[0] == '[' || rest[0] == ':') {
+		// a link, its label or the definition of a reference
+		return nil
+	}
+
+	node := NewInlineAdmonition(bytes.ToLower(line[match[2]:match[3]]))
+	node.AppendChild(node, ast.NewTextSegment(text.NewSegment(segment.Start+match[4], segment.Start+match[5])))
+	block.Advance(match[1])
+	return node
+}
+
+// renderInlineAdmonition renders n as a <span>, or as a status macro for
+// Confluence
+func (r *Renderer) renderInlineAdmonition(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*InlineAdmonition)
+	if r.Target == TargetConfluence {
+		if entering {
+			_, _ = w.WriteString(`<ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">`)
+			_, _ = w.WriteString(confluenceStatusColours[r.confluenceClassMacro(string(n.AdmonitionClass))])
+			_, _ = w.WriteString(`</ac:parameter><ac:parameter ac:name="title">`)
+			_, _ = w.Write(util.EscapeHTML(plainText(n, source)))
+			_, _ = w.WriteString("</ac:parameter></ac:structured-macro>")
+		}
+		return ast.WalkSkipChildren, nil
+	}
+
+	if !entering {
+		_, _ = w.WriteString("</span>")
+		return ast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<span` + r.classAttribute("admonition-inline adm-"+string(n.AdmonitionClass), string(n.AdmonitionClass)) + `>`)
+	return ast.WalkContinue, nil
+}
@@ -0,0 +1,132 @@
+package admonitions
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+)
+
+// An Issue is an admonition tagged to be tracked as a ticket, e.g.
+// "!!!warning Flaky on Windows {track=issue owner=ci}", see Issues
+type Issue struct {
+	File       string            `json:"file"`
+	Line       int               `json:"line"` // the line of the opening tag, 0 if not from source
+	Type       string            `json:"type"`
+	Title      string            `json:"title,omitempty"`
+	Body       string            `json:"body"`                 // the body as Markdown
+	Attributes map[string]string `json:"attributes,omitempty"` // the other attributes, e.g. "owner"
+}
+
+// issueAttributes are the attributes Issue.Attributes leaves out, set by the
+// parsers rather than written
+var issueAttributes = map[string]bool{"class": true, "data-admonition": true}
+
+// Issues returns the admonitions of doc, parsed from the file file, whose
+// attribute has the value value, e.g. "track" and "issue", in document order.
+// Blockquotes count if md converted them to admonitions.
+func Issues(file string, doc ast.Node, source []byte, attribute, value string) []Issue {
+	issues := []Issue{}
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		n, ok := node.(*Admonition)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		if tag, ok := n.AttributeString(attribute); !ok || string(attributeBytes(tag)) != value {
+			return ast.WalkContinue, nil
+		}
+
+		issue := Issue{
+			File:  file,
+			Type:  string(n.AdmonitionClass),
+			Title: string(n.Title),
+			Body:  bodyMarkdown(n, source),
+		}
+		if n.Opener.Len() > 0 && n.Opener.Stop <= len(source) {
+			issue.Line = lineAt(source, n.Opener.Start)
+		}
+		for _, attr := range n.Attributes() {
+			if name := string(attr.Name); name != attribute && !issueAttributes[name] {
+				if issue.Attributes == nil {
+					issue.Attributes = map[string]string{}
+				}
+				issue.Attributes[name] = string(attributeBytes(attr.Value))
+			}
+		}
+		issues = append(issues, issue)
+		return ast.WalkContinue, nil
+	})
+	return issues
+}
+
+// IssuesTree returns the Issues of all Markdown files of fsys, parsed with md
+func IssuesTree(fsys fs.FS, md goldmark.Markdown, attribute, value string) ([]Issue, error) {
+	issues := []Issue{}
+	err := walkMarkdownFiles(fsys, md, func(p string, source []byte, doc ast.Node, pc parser.Context) error {
+		issues = append(issues, Issues(p, doc, source, attribute, value)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// WriteIssues writes issues as indented JSON, the Markdown of bodies
+// unescaped
+func WriteIssues(w io.Writer, issues []Issue) error {
+	if issues == nil {
+		issues = []Issue{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(issues)
+}
+
+// bodyMarkdown returns the source of the body of n without the quote markers
+// of blockquote admonitions and the common indentation of indented ones
+func bodyMarkdown(n *Admonition, source []byte) string {
+	if n.Body.Start < 0 || n.Body.Stop > len(source) || n.Body.Len() <= 0 {
+		return ""
+	}
+	lines := strings.Split(string(n.Body.Value(source)), "\n")
+
+	// the quotes the marker is in, e.g. 2 for "> > [!NOTE]"
+	depth := 0
+	if _, marker := n.Marker(); marker.Len() > 0 && marker.Start <= len(source) {
+		start := bytes.LastIndexByte(source[:marker.Start], '\n') + 1
+		depth = bytes.Count(source[start:marker.Start], []byte{'>'})
+	}
+	for i, line := range lines {
+		for j := 0; j < depth; j++ {
+			trimmed := strings.TrimLeft(line, " \t")
+			if !strings.HasPrefix(trimmed, ">") {
+				break
+			}
+			line = strings.TrimPrefix(trimmed[1:], " ")
+		}
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+
+	indent := -1
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		if width := len(line) - len(strings.TrimLeft(line, " ")); indent < 0 || width < indent {
+			indent = width
+		}
+	}
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			lines[i] = line[indent:]
+		}
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
@@ -0,0 +1,86 @@
+package admonitions
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// A Kind configures a custom admonition class in terms of another one, e.g.
+//
+//	"security": {Inherits: "warning"}
+//
+// renders "!!!security" like "!!!warning": with its icon, as its Confluence
+// macro and with its adm-warning class, so its colours apply as well. Set
+// whatever should differ, an icon of the class itself in Config.Icons takes
+// precedence too.
+type Kind struct {
+	Inherits        string // the class whose rendering is inherited
+	ConfluenceMacro string // the Confluence macro, inherited if empty
+}
+
+// kindChain returns class followed by the classes it inherits from, closest
+// first. Cycles end the chain.
+func kindChain(kinds map[string]Kind, class string) []string {
+	chain := []string{class}
+	seen := map[string]bool{class: true}
+	for {
+		kind, ok := kinds[class]
+		if !ok || kind.Inherits == "" || seen[kind.Inherits] {
+			return chain
+		}
+		class = kind.Inherits
+		seen[class] = true
+		chain = append(chain, class)
+	}
+}
+
+// kindsTransformer replaces the classes of admonitions that are aliases,
+// "admonition adm-hint" becoming "admonition adm-tip", and adds the classes
+// inherited by admonitions to their class attribute, e.g. "admonition
+// adm-security adm-warning"
+type kindsTransformer struct {
+	kinds   map[string]Kind
+	aliases map[string]string // see WithTypeAliases
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *kindsTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		n, ok := node.(*Admonition)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		class, _ := n.AttributeString("class")
+		value, _ := class.([]byte)
+		if canonical, ok := t.aliases[string(n.AdmonitionClass)]; ok {
+			value = replaceClass(value, "adm-"+string(n.AdmonitionClass), "adm-"+canonical)
+			n.AdmonitionClass = []byte(canonical)
+			n.SetAttributeString("class", value)
+		}
+
+		chain := kindChain(t.kinds, string(n.AdmonitionClass))
+		if len(chain) < 2 {
+			return ast.WalkContinue, nil
+		}
+		value = append([]byte{}, value...)
+		for _, inherited := range chain[1:] {
+			value = append(value, " adm-"+inherited...)
+		}
+		n.SetAttributeString("class", value)
+		return ast.WalkContinue, nil
+	})
+}
+
+// replaceClass returns the space separated classes with old replaced by new
+func replaceClass(classes []byte, old, new string) []byte {
+	fields := bytes.Fields(classes)
+	for i, class := range fields {
+		if string(class) == old {
+			fields[i] = []byte(new)
+		}
+	}
+	return bytes.Join(fields, []byte(" "))
+}
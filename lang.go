@@ -0,0 +1,32 @@
+package admonitions
+
+import (
+	"github.com/yuin/goldmark/util"
+)
+
+// langAttribute is the attribute setting the language of an admonition, e.g.
+// !!!note Remarque {lang=fr}
+var langAttribute = []byte("lang")
+
+// Lang returns the value of the lang attribute and whether it is present and
+// not empty. HTML renders it on the wrapper like every global attribute.
+func (n *Admonition) Lang() (string, bool) {
+	if value, ok := n.Attribute(langAttribute); ok {
+		if lang := attributeBytes(value); len(lang) > 0 {
+			return string(lang), true
+		}
+	}
+	return "", false
+}
+
+// writeConfluenceLang opens a <div lang=""> around the body of n, as macros
+// have no language of their own, if n has one
+func writeConfluenceLang(w util.BufWriter, n *Admonition) {
+	lang, ok := n.Lang()
+	if !ok {
+		return
+	}
+	_, _ = w.WriteString(`<div lang="`)
+	_, _ = w.Write(util.EscapeHTML([]byte(lang)))
+	_, _ = w.WriteString("\">\n")
+}
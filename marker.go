@@ -0,0 +1,55 @@
+package admonitions
+
+import (
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// Marker returns what made n an admonition as written and where it is in the
+// source, e.g. "!!!note", "[!WARNING]" or "**Warning:**", for preview tools
+// to highlight. Admonitions not parsed from a source have none.
+func (n *Admonition) Marker() ([]byte, text.Segment) {
+	return n.marker, n.markerSegment
+}
+
+// setMarker sets the marker of n to the bytes [start, stop) of source
+func (n *Admonition) setMarker(source []byte, start, stop int) {
+	n.markerSegment = text.NewSegment(start, stop)
+	n.marker = n.markerSegment.Value(source)
+}
+
+// The markers blockQuoteMarker looks for, the alert including stepped ones
+var (
+	alertMarkerLine = regexp.MustCompile(`^[ \t]*(\[![^\]]+\])`)
+	boldLabelLine   = regexp.MustCompile(`^[ \t]*((\*\*|__)[A-Za-z]+[ \t]*:?(\*\*|__):?)`)
+)
+
+// blockQuoteMarker sets the marker of n, made of quote classified as bqType:
+// its alert marker, its bold label or the keyword of its type
+func blockQuoteMarker(n *Admonition, quote ast.Node, bqType BlockQuoteType, boldLabels bool, source []byte) {
+	block := firstTextBlock(quote)
+	if block == nil || source == nil {
+		return
+	}
+	line := block.Lines().At(0)
+	value := line.Value(source)
+
+	if match := alertMarkerLine.FindSubmatchIndex(value); match != nil {
+		n.setMarker(source, line.Start+match[2], line.Start+match[3])
+		return
+	}
+	if match := boldLabelLine.FindSubmatchIndex(value); match != nil && boldLabels {
+		n.setMarker(source, line.Start+match[2], line.Start+match[3])
+		return
+	}
+	for _, c := range classifierTypes {
+		if c.t != bqType {
+			continue
+		}
+		if match := legacyClassifier.patternMap[c.key].FindIndex(value); match != nil {
+			n.setMarker(source, line.Start+match[0], line.Start+match[1])
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package admonitions
+
+// The markers of WithMarkers
+const (
+	markerFence  = "!!!" // fenced admonitions, and MkDocs' with WithMkDocs
+	markerColons = ":::" // directives, or Pandoc divs with WithPandocDivs
+	markerQuote  = ">"   // blockquotes classified as admonitions
+	markerRST    = ".."  // reStructuredText directives with WithReStructuredText
+)
+
+// active reports whether WithMarkers left marker active
+func (e *Extender) active(marker string) bool {
+	return e.markers == nil || e.markers[marker]
+}
+
+// parsesFences reports whether "!!!note" admonitions are parsed
+func (e *Extender) parsesFences() bool {
+	return e.active(markerFence)
+}
+
+// parsesMkDocs reports whether MkDocs admonitions are parsed
+func (e *Extender) parsesMkDocs() bool {
+	return e.mkdocs && e.active(markerFence)
+}
+
+// parsesRST reports whether reStructuredText directives are parsed
+func (e *Extender) parsesRST() bool {
+	return e.rst && e.active(markerRST)
+}
+
+// parsesDirectives reports whether ":::note" directives are parsed, with
+// WithMarkers listing ":::" unless they are left to WithPandocDivs
+func (e *Extender) parsesDirectives() bool {
+	if e.markers == nil {
+		return e.directives
+	}
+	return e.markers[markerColons] && (e.directives || !e.pandoc)
+}
+
+// parsesPandoc reports whether Pandoc fenced divs are parsed
+func (e *Extender) parsesPandoc() bool {
+	return e.pandoc && e.active(markerColons)
+}
+
+// convertsBlockQuotes reports whether classified blockquotes become
+// admonitions, with WithMarkers listing ">" where they end defaulting to
+// EndOfQuote
+func (e *Extender) convertsBlockQuotes() bool {
+	if e.markers == nil {
+		return e.blockQuotes
+	}
+	return e.markers[markerQuote]
+}
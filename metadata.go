@@ -0,0 +1,43 @@
+package admonitions
+
+import (
+	"encoding/json"
+
+	"github.com/yuin/goldmark/util"
+)
+
+// Metadata describes an admonition to client side scripts, see
+// Config.Metadata
+type Metadata struct {
+	Type  string `json:"type"`
+	Title string `json:"title,omitempty"`
+	ID    string `json:"id,omitempty"`
+	Lang  string `json:"lang,omitempty"`
+	Step  int    `json:"step,omitempty"`
+}
+
+// metadata returns the metadata of n
+func (r *Renderer) metadata(n *Admonition) Metadata {
+	m := Metadata{
+		Type:  string(n.AdmonitionClass),
+		Title: string(r.title(n)),
+	}
+	if id, ok := n.AttributeString("id"); ok {
+		m.ID = string(attributeBytes(id))
+	}
+	m.Lang, _ = n.Lang()
+	m.Step, _ = n.Step()
+	return m
+}
+
+// writeMetadata writes the metadata of n as a JSON script element. json
+// escapes <, > and &, so the payload can't end the script early.
+func (r *Renderer) writeMetadata(w util.BufWriter, n *Admonition) {
+	payload, err := json.Marshal(r.metadata(n))
+	if err != nil {
+		return
+	}
+	_, _ = w.WriteString("  <script type=\"application/json\"" + r.classAttribute("adm-metadata", string(n.AdmonitionClass)) + ">")
+	_, _ = w.Write(payload)
+	_, _ = w.WriteString("</script>\n")
+}
@@ -0,0 +1,166 @@
+package admonitions
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// mkdocsOpener matches the first line of MkDocs admonitions, the marker in
+// the first group, the classes in the second and the quoted title, if any, in
+// the third
+var mkdocsOpener = regexp.MustCompile(`^(!!!|\?\?\?\+?) +([\w-]+(?: +[\w-]+)*)(?: +"(.*)")?[ \t]*\r?\n?$`)
+
+// mkdocsIndent is how much deeper than their opener MkDocs bodies are indented
+const mkdocsIndent = 4
+
+// mkdocsOffsetsKey maps the open MkDocs admonitions to the indentation of
+// their openers
+var mkdocsOffsetsKey = parser.NewContextKey()
+
+// mkdocsParser parses admonitions as written for MkDocs and python-markdown,
+// with a quoted title and a body indented by four spaces:
+//
+//	!!! warning "Careful"
+//	    This is the body.
+//
+// Without a title, the capitalised type is the title, `""` means no title.
+// Lines that aren't indented end the admonition. The collapsible variants
+// `??? note` and `???+ note`, expanded by default, set the collapse attribute.
+type mkdocsParser struct{}
+
+// Trigger implements parser.BlockParser.Trigger .
+func (b *mkdocsParser) Trigger() []byte {
+	return []byte{'!', '?'}
+}
+
+// Open implements parser.BlockParser.Open .
+func (b *mkdocsParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	match := mkdocsOpener.FindSubmatch(line[pos:])
+	if match == nil {
+		return nil, parser.NoChildren
+	}
+	markerStop := pos + bytes.Index(line[pos:], match[2]) + len(match[2])
+	offset, _ := util.IndentWidth(line, reader.LineOffset())
+
+	classes := bytes.Fields(match[2])
+	node := NewAdmonition()
+	node.AdmonitionClass = classes[0]
+	node.Title = capitalize(classes[0])
+	if match[3] != nil {
+		node.Title = match[3]
+	}
+	class := admonitionClassAttribute(classes[0])
+	for _, extra := range classes[1:] {
+		class = append(append(class, ' '), extra...)
+	}
+	node.SetAttributeString("class", class)
+	depth := 0
+	for p := parent; p != nil; p = p.Parent() {
+		if p.Kind() == KindAdmonition {
+			depth++
+		}
+	}
+	node.SetAttributeString("data-admonition", []byte(fmt.Sprint(depth)))
+	switch string(match[1]) {
+	case "???":
+		node.SetAttribute(collapseAttribute, []byte("closed"))
+	case "???+":
+		node.SetAttribute(collapseAttribute, []byte("open"))
+		node.DefaultOpen = true
+	}
+	node.Opener = text.NewSegment(segment.Start, segment.Stop)
+	node.Body = text.NewSegment(segment.Stop, -1)
+	node.setMarker(reader.Source(), segment.Start+pos, segment.Start+markerStop)
+
+	offsets, _ := pc.Get(mkdocsOffsetsKey).(map[ast.Node]int)
+	if offsets == nil {
+		offsets = map[ast.Node]int{}
+		pc.Set(mkdocsOffsetsKey, offsets)
+	}
+	offsets[node] = offset
+
+	reader.Advance(segment.Len() - 1)
+	return node, parser.HasChildren
+}
+
+// capitalize returns class with its first letter in upper case
+func capitalize(class []byte) []byte {
+	title := append([]byte{}, class...)
+	if len(title) > 0 && title[0] >= 'a' && title[0] <= 'z' {
+		title[0] -= 'a' - 'A'
+	}
+	return title
+}
+
+// Continue implements parser.BlockParser.Continue .
+func (b *mkdocsParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	if util.IsBlank(line) {
+		reader.Advance(len(line) - 1)
+		return parser.Continue | parser.HasChildren
+	}
+
+	offsets, _ := pc.Get(mkdocsOffsetsKey).(map[ast.Node]int)
+	offset := offsets[node] + mkdocsIndent
+	indent, _ := util.IndentWidth(line, reader.LineOffset())
+	if indent < offset {
+		return parser.Close
+	}
+	pos, padding := util.IndentPosition(line, reader.LineOffset(), offset)
+	reader.AdvanceAndSetPadding(pos, padding)
+	return parser.Continue | parser.HasChildren
+}
+
+// Close implements parser.BlockParser.Close .
+func (b *mkdocsParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {
+	if offsets, ok := pc.Get(mkdocsOffsetsKey).(map[ast.Node]int); ok {
+		delete(offsets, node)
+	}
+
+	n := node.(*Admonition)
+	_, segment := reader.Position()
+	n.Body.Stop = bodyStop(reader.Source(), n.Body.Start, segment.Start)
+}
+
+// bodyStop returns where the body starting at start ends if the line at
+// offset is the first one not belonging to it. Like python-markdown, blank
+// lines continue indented bodies as long as an indented line follows, so the
+// blank lines preceding that line don't belong to the body either.
+func bodyStop(source []byte, start, offset int) int {
+	stop := len(source)
+	if offset < len(source) {
+		stop = bytes.LastIndexByte(source[:offset], '\n') + 1
+	}
+	for stop > start {
+		previous := bytes.LastIndexByte(source[:stop-1], '\n') + 1
+		if previous < start || !util.IsBlank(source[previous:stop]) {
+			break
+		}
+		stop = previous
+	}
+	if stop < start {
+		stop = start
+	}
+	return stop
+}
+
+// CanInterruptParagraph implements parser.BlockParser.CanInterruptParagraph .
+func (b *mkdocsParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser.CanAcceptIndentedLine .
+func (b *mkdocsParser) CanAcceptIndentedLine() bool {
+	return false
+}
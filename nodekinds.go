@@ -0,0 +1,36 @@
+package admonitions
+
+import "github.com/yuin/goldmark/ast"
+
+// nodeKinds are the node kinds of this package. Their names are part of the
+// API, tools persisting ASTs by kind name can rely on them staying the same.
+var nodeKinds = []ast.NodeKind{
+	KindAdmonition,
+	KindInlineAdmonition,
+	KindAbbreviation,
+	KindKeys,
+	KindProblem,
+	KindTab,
+}
+
+// NodeKinds returns the node kinds of this package by name, e.g.
+// "Admonition" for KindAdmonition, which is what their String method
+// returns.
+func NodeKinds() map[string]ast.NodeKind {
+	kinds := make(map[string]ast.NodeKind, len(nodeKinds))
+	for _, kind := range nodeKinds {
+		kinds[kind.String()] = kind
+	}
+	return kinds
+}
+
+// ParseNodeKind returns the node kind of this package whose String is name,
+// e.g. KindAdmonition for "Admonition", and whether there is one.
+func ParseNodeKind(name string) (ast.NodeKind, bool) {
+	for _, kind := range nodeKinds {
+		if kind.String() == name {
+			return kind, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,69 @@
+package admonitions
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// numberAttribute records the number of a numbered admonition, e.g. "2.3"
+var numberAttribute = []byte("data-number")
+
+// numberingTransformer numbers the admonitions of a document per class, see
+// WithNumbering
+type numberingTransformer struct {
+	sectionLevel int // the deepest heading level restarting the counters, 0 for none
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *numberingTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	counters := map[string]int{}
+	sections := make([]int, t.sectionLevel)
+	depth := 0 // the level of the last heading restarting the counters
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := node.(type) {
+		case *ast.Heading:
+			if n.Level > t.sectionLevel || closestAdmonition(n) != nil {
+				return ast.WalkSkipChildren, nil
+			}
+			sections[n.Level-1]++
+			for i := n.Level; i < len(sections); i++ {
+				sections[i] = 0
+			}
+			depth = n.Level
+			counters = map[string]int{}
+			return ast.WalkSkipChildren, nil
+		case *Admonition:
+			if n.IsRaw() || len(n.AdmonitionClass) == 0 {
+				return ast.WalkContinue, nil
+			}
+			if _, step := n.Attribute(stepAttribute); step {
+				return ast.WalkContinue, nil
+			}
+			class := string(n.AdmonitionClass)
+			counters[class]++
+
+			var parts []string
+			for _, section := range sections[:depth] {
+				parts = append(parts, fmt.Sprint(section))
+			}
+			number := strings.Join(append(parts, fmt.Sprint(counters[class])), ".")
+			n.SetAttribute(numberAttribute, []byte(number))
+
+			label := capitalize(n.AdmonitionClass)
+			if len(n.Title) == 0 || bytes.EqualFold(n.Title, label) {
+				n.Title = []byte(fmt.Sprintf("%s %s", label, number))
+			} else {
+				n.Title = []byte(fmt.Sprintf("%s %s: %s", label, number, n.Title))
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+}
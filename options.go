@@ -0,0 +1,716 @@
+package admonitions
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// An Option configures the Extender
+type Option func(*Extender)
+
+// New returns a new Extender configured with the given options. The zero
+// value of Extender is usable as well and equals New().
+func New(opts ...Option) *Extender {
+	e := &Extender{}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// WithPriority sets the priority of the parser and renderer. Defaults to 100.
+func WithPriority(priority int) Option {
+	return func(e *Extender) {
+		e.priority = priority
+	}
+}
+
+// WithTitleElement sets the HTML element titles are rendered as. Defaults to
+// TitleDiv.
+func WithTitleElement(element TitleElement) Option {
+	return func(e *Extender) {
+		e.config.TitleElement = element
+	}
+}
+
+// WithTitleLevel sets the heading level (1-6) used with TitleHeading. With 0,
+// the default, the level is one below the closest preceding heading.
+func WithTitleLevel(level int) Option {
+	return func(e *Extender) {
+		e.config.TitleLevel = level
+	}
+}
+
+// WithTitleFunc computes the titles of admonitions when they are rendered,
+// e.g. to localise them or to derive them from the body. The authored title
+// is n.Title, an empty result renders no title.
+func WithTitleFunc(title func(n *Admonition) string) Option {
+	return func(e *Extender) {
+		e.config.TitleFunc = title
+	}
+}
+
+// WithTitleFilter rewrites every title before it is rendered, e.g. to replace
+// "Danger" with "Critical" across all documents. It applies after
+// WithTitleFunc.
+func WithTitleFilter(filter func(title string) string) Option {
+	return func(e *Extender) {
+		e.config.TitleFilter = filter
+	}
+}
+
+// WithResponsive renders every admonition both always open and as a
+// collapsible <details> element, see Config.Responsive.
+func WithResponsive() Option {
+	return func(e *Extender) {
+		e.config.Responsive = true
+	}
+}
+
+// WithWordLimit collapses the body of admonitions after about words words into
+// a "Show more" expander. Bodies are cut between blocks.
+func WithWordLimit(words int) Option {
+	return func(e *Extender) {
+		e.config.WordLimit = words
+	}
+}
+
+// WithCollapseScript writes CollapseScript into every document with
+// collapsible admonitions, so their open state is kept in localStorage.
+func WithCollapseScript() Option {
+	return func(e *Extender) {
+		e.config.CollapseScript = true
+	}
+}
+
+// WithFigures renders admonitions whose only content is an image, e.g. a
+// screenshot, as a <figure> with the title as its <figcaption>.
+func WithFigures() Option {
+	return func(e *Extender) {
+		e.config.Figures = true
+	}
+}
+
+// WithApprovalFooter renders the approved-by attribute of admonitions in a
+// footer line.
+func WithApprovalFooter() Option {
+	return func(e *Extender) {
+		e.config.ApprovalFooter = true
+	}
+}
+
+// WithVars replaces {{name}} placeholders inside admonition titles and bodies
+// with the given values, e.g. to keep version numbers in callouts current.
+// Placeholders outside of admonitions are left alone.
+func WithVars(vars map[string]string) Option {
+	return func(e *Extender) {
+		e.vars = vars
+	}
+}
+
+// WithFlags sets the flags the if attribute of admonitions is evaluated
+// against, e.g. with WithFlags("beta") "!!!note New {if=beta}" is rendered
+// and "!!!note Old {if="!beta"}" is not. Several flags can be combined with
+// commas, all of them have to hold.
+func WithFlags(flags ...string) Option {
+	return func(e *Extender) {
+		e.config.Flags = make(map[string]bool, len(flags))
+		for _, flag := range flags {
+			e.config.Flags[flag] = true
+		}
+	}
+}
+
+// WithIcons renders SVG icons in front of the titles of admonitions, looked
+// up by class. DefaultIcons covers the common classes.
+func WithIcons(icons map[string]Icon) Option {
+	return func(e *Extender) {
+		e.config.Icons = icons
+	}
+}
+
+// WithIconSprite writes every icon only once per document, in a hidden SVG
+// sprite referenced by the admonitions. This keeps icon heavy pages small.
+func WithIconSprite() Option {
+	return func(e *Extender) {
+		e.config.IconSprite = true
+	}
+}
+
+// WithIconURLs loads icons lazily from external URLs instead of inlining SVG,
+// which lets browsers cache them. urls maps classes to URLs, all other
+// classes use baseURL + class + ".svg", unless baseURL is empty.
+func WithIconURLs(baseURL string, urls map[string]string) Option {
+	return func(e *Extender) {
+		e.config.IconBaseURL = baseURL
+		e.config.IconURLs = urls
+	}
+}
+
+// WithIconChains renders the first available icon of the chain of each
+// class, a file, an Iconify icon or a Unicode symbol, so there is an icon
+// even if assets are missing. available decides whether the file or Iconify
+// URL of a chain can be used; with nil, local files have to exist and URLs
+// are assumed to load.
+func WithIconChains(chains map[string]IconChain, available func(src string) bool) Option {
+	return func(e *Extender) {
+		e.config.IconChains = chains
+		e.config.IconAvailable = available
+	}
+}
+
+// WithAbbreviations expands the given terms in the titles and bodies of
+// admonitions as <abbr> elements, e.g. {"HTML": "HyperText Markup Language"}.
+// Confluence output only keeps the term.
+func WithAbbreviations(abbreviations map[string]string) Option {
+	return func(e *Extender) {
+		e.config.Abbreviations = abbreviations
+	}
+}
+
+// WithExpiry hides or archives admonitions after the day of their expires
+// attribute, e.g. a warning with {expires=2025-06-30} is rendered until the
+// end of June 30th 2025. See WithClock for builds at another date.
+func WithExpiry(expiry Expiry) Option {
+	return func(e *Extender) {
+		e.config.Expiry = expiry
+	}
+}
+
+// WithStats measures how long rendering each admonition takes and collects
+// the Timings in stats, e.g. to find the callouts with huge tables or images
+// slowing page builds down. It measures with time.Now, or Stats.Now if set.
+func WithStats(stats *Stats) Option {
+	return func(e *Extender) {
+		e.config.Stats = stats
+	}
+}
+
+// WithClock sets the clock WithExpiry compares dates with, e.g. the date of
+// the release the documentation is built for
+func WithClock(now func() time.Time) Option {
+	return func(e *Extender) {
+		e.config.Clock = now
+	}
+}
+
+// WithTicketLinks links references to tickets in the titles and bodies of
+// admonitions, e.g. for release notes:
+//
+//	WithTicketLinks(TicketLink{
+//		Pattern: regexp.MustCompile(`\bJIRA-\d+\b`),
+//		URL:     "https://jira.example.com/browse/$0",
+//	})
+func WithTicketLinks(links ...TicketLink) Option {
+	return func(e *Extender) {
+		e.config.TicketLinks = append(e.config.TicketLinks, links...)
+	}
+}
+
+// WithoutTitleAbbreviations keeps titles as they are with WithAbbreviations,
+// expanding abbreviations in bodies only.
+func WithoutTitleAbbreviations() Option {
+	return func(e *Extender) {
+		e.config.NoTitleAbbreviations = true
+	}
+}
+
+// WithScreenReaderLabels writes a visually hidden <span class="sr-only">
+// announcing the type of every admonition, e.g. "Warning:", for screen
+// readers. texts maps classes to labels, other classes are capitalised.
+// Themes need to define the sr-only class.
+func WithScreenReaderLabels(texts map[string]string) Option {
+	return func(e *Extender) {
+		e.config.ScreenReaderLabels = true
+		e.config.ScreenReaderTexts = texts
+	}
+}
+
+// WithKinds registers custom classes that inherit the icon, Confluence macro
+// and CSS class of another class, e.g. to render many organisation specific
+// kinds like the built-in ones.
+func WithKinds(kinds map[string]Kind) Option {
+	return func(e *Extender) {
+		e.config.Kinds = kinds
+	}
+}
+
+// WithRestrictions hardens admonitions for user-generated content, whatever
+// goldmark is configured with: within them raw HTML is omitted, links and
+// images not using one of the Protocols of restrictions render as their text,
+// images beyond MaxImages as their alt text, and event handler attributes
+// like onclick are dropped. Raw admonitions are omitted even with
+// WithUnsafe.
+func WithRestrictions(restrictions Restrictions) Option {
+	return func(e *Extender) {
+		e.restrictions = &restrictions
+		e.config.Restricted = true
+	}
+}
+
+// WithContentTabs parses MkDocs content tabs inside admonitions, e.g. to show
+// one example in several languages:
+//
+//	=== "Go"
+//	    fmt.Println("hi")
+//	=== "Python"
+//	    print("hi")
+//
+// They render like pymdownx.tabbed, so MkDocs themes style them.
+func WithContentTabs() Option {
+	return func(e *Extender) {
+		e.tabs = true
+	}
+}
+
+// WithNumbering numbers admonitions per type in document order, the title
+// "Careful" of the second warning becoming "Warning 2: Careful". The number is
+// the data-number attribute as well. For book-length documents, headings up to
+// sectionLevel restart the numbers, which then include the number of the
+// section, e.g. "Warning 2.3" for the third warning of the second chapter with
+// a sectionLevel of 1. A sectionLevel of 0 or less restarts them at no heading.
+func WithNumbering(sectionLevel int) Option {
+	return func(e *Extender) {
+		e.numbering = true
+		if sectionLevel < 0 {
+			sectionLevel = 0
+		}
+		e.sectionLevel = sectionLevel
+	}
+}
+
+// WithTypeAliases replaces the classes of admonitions by canonical ones
+// while parsing, e.g. {"hint": "tip", "attention": "warning"} turns
+// "!!!hint" into an admonition of class tip, as if it was written that way.
+// Unlike a Kind inheriting from tip, there's no adm-hint class left.
+func WithTypeAliases(aliases map[string]string) Option {
+	return func(e *Extender) {
+		e.aliases = aliases
+	}
+}
+
+// WithTarget sets the output format. Defaults to TargetHTML.
+func WithTarget(target Target) Option {
+	return func(e *Extender) {
+		e.config.Target = target
+	}
+}
+
+// WithComponentTag sets the custom element TargetWebComponent renders
+// admonitions as, e.g. "acme-callout". Defaults to "doc-admonition", which is
+// used for tags that aren't valid custom element names as well: lowercase,
+// with a hyphen and without spaces or quotes.
+func WithComponentTag(tag string) Option {
+	return func(e *Extender) {
+		e.config.ComponentTag = tag
+	}
+}
+
+// WithConfluenceOutput sets whether TargetConfluence renders a bare fragment,
+// the default, or a complete page with the namespaces declared.
+func WithConfluenceOutput(output ConfluenceOutput) Option {
+	return func(e *Extender) {
+		e.config.ConfluenceOutput = output
+	}
+}
+
+// WithConfluenceParameters passes the given attributes of admonitions on to
+// their Confluence macros as parameters, keyed by attribute name, e.g.
+// {"icon": "icon"} turns "!!!note {icon=false}" into a note without an icon.
+func WithConfluenceParameters(params map[string]string) Option {
+	return func(e *Extender) {
+		e.config.ConfluenceParameters = params
+	}
+}
+
+// WithSeverityMacros renders admonitions with a severity, e.g. "!!!2 warning"
+// or "> [!WARNING:2]", as the Confluence macro macros maps it to, e.g.
+// {1: "info", 2: "note", 3: "warning"}. Other admonitions are rendered as
+// the macros of their classes.
+func WithSeverityMacros(macros map[int]string) Option {
+	return func(e *Extender) {
+		e.config.SeverityMacros = macros
+	}
+}
+
+// WithConfluenceIcons configures the icons of the Confluence macros of the
+// given classes, e.g. {"tip": {Hide: true}} for tips without an icon or
+// {"security": {Emoji: "🔒"}} for panels showing a lock. An icon attribute
+// passed on by WithConfluenceParameters still wins.
+func WithConfluenceIcons(icons map[string]ConfluenceIcon) Option {
+	return func(e *Extender) {
+		e.config.ConfluenceIcons = icons
+	}
+}
+
+// WithConfluenceDiagramMacros passes fenced code blocks of the given
+// languages in admonitions unmodified to Confluence macros, e.g.
+// {"mermaid": "mermaid-cloud"} for a diagram app installed in Confluence.
+func WithConfluenceDiagramMacros(macros map[string]string) Option {
+	return func(e *Extender) {
+		e.config.ConfluenceDiagramMacros = macros
+	}
+}
+
+// WithCompactThreshold renders admonitions without a title whose body is a
+// single paragraph of at most threshold characters as Confluence status
+// macros instead of full macros.
+func WithCompactThreshold(threshold int) Option {
+	return func(e *Extender) {
+		e.config.CompactThreshold = threshold
+	}
+}
+
+// WithUnsafe writes the body of raw admonitions ("!!!raw") to the output.
+// Without it they are omitted, like raw HTML in goldmark. This is
+// deliberately separate from html.WithUnsafe, which writes raw HTML in the
+// bodies of admonitions but not raw admonitions.
+func WithUnsafe() Option {
+	return func(e *Extender) {
+		e.config.Unsafe = true
+	}
+}
+
+// WithMetadata writes a <script type="application/json"> with the Metadata of
+// every admonition into its wrapper, e.g. for hydrating single page apps.
+func WithMetadata() Option {
+	return func(e *Extender) {
+		e.config.Metadata = true
+	}
+}
+
+// WithClassScope appends "-" + scope to every class this package emits, e.g.
+// adm-title-docs, so several documents on one page don't share their styles.
+// ScopeHash derives a short scope from a name.
+func WithClassScope(scope string) Option {
+	return func(e *Extender) {
+		e.config.ClassScope = scope
+	}
+}
+
+// WithClassStrategy names the classes this package emits with strategy, e.g.
+// BEMClasses or the UtilityClasses of a CSS framework. It replaces
+// WithClassScope, a strategy can append a scope itself.
+func WithClassStrategy(strategy ClassStrategy) Option {
+	return func(e *Extender) {
+		e.config.ClassStrategy = strategy
+	}
+}
+
+// WithUnwrap renders only the bodies of admonitions if unwrap is set, with
+// neither wrapper nor title, e.g. to generate plain summaries or search
+// snippets from the same documents.
+func WithUnwrap(unwrap bool) Option {
+	return func(e *Extender) {
+		e.config.Unwrap = unwrap
+	}
+}
+
+// WithRandomIDs gives admonitions that are still open at the end of the
+// document a random data-admonition instead of their level, as earlier
+// versions did. Without it, the output only depends on the input and the
+// options.
+func WithRandomIDs() Option {
+	return func(e *Extender) {
+		e.randomIDs = true
+	}
+}
+
+// WithFailFast makes goldmark's Convert fail on unexpected conditions if
+// failFast is set, instead of silently falling back, e.g. in CI builds:
+// blockquotes with unknown alert types, unknown {{name}} placeholders with
+// WithVars, expires attributes which aren't dates with WithExpiry, segments
+// outside of the source and nodes no renderer renders.
+func WithFailFast(failFast bool) Option {
+	return func(e *Extender) {
+		e.config.FailFast = failFast
+	}
+}
+
+// WithSourceMap adds the source lines of every admonition to its wrapper as
+// data-source-lines="start-end", so editors can scroll-sync the preview. See
+// SourceMap for the same information as JSON.
+func WithSourceMap() Option {
+	return func(e *Extender) {
+		e.config.SourceMap = true
+	}
+}
+
+// WithMarkers activates only the given markers, "!!!" for fenced and MkDocs
+// admonitions, ":::" for directives or Pandoc divs, ">" for blockquote
+// admonitions and ".." for reStructuredText directives, e.g. to leave ":::"
+// to another extension:
+//
+//	admonitions.New(admonitions.WithMarkers("!!!", ">"))
+//
+// Listing ":::" parses directives unless WithPandocDivs is set, and ">"
+// converts blockquotes, ending where WithBlockQuoteAdmonitions says or at
+// the end of the quote. Other markers are ignored. Without WithMarkers every
+// configured marker is active.
+func WithMarkers(markers ...string) Option {
+	return func(e *Extender) {
+		e.markers = map[string]bool{}
+		for _, marker := range markers {
+			e.markers[marker] = true
+		}
+	}
+}
+
+// WithMkDocs parses admonitions written for MkDocs and python-markdown as
+// well, with quoted titles and bodies indented by four spaces:
+//
+//	!!! warning "Careful"
+//	    This is the body.
+//
+// "!!! " followed by a type opens them, so "!!! note Title" followed by an
+// unindented body needs to become "!!!note Title".
+func WithMkDocs() Option {
+	return func(e *Extender) {
+		e.mkdocs = true
+	}
+}
+
+// WithOptionsLine reads a line of key=value pairs right after the opening
+// line into the Options of the admonition, values with spaces quoted:
+//
+//	!!!tip Launch
+//	icon=rocket open=false color="rgb(255 140 0)"
+//	The body.
+//	!!!
+//
+// icon renders the icon of another class, open makes the admonition
+// collapsible, open or closed, and color sets the CSS custom property
+// --adm-color to a named, hex, rgb() or hsl() color, other values are
+// dropped. Other keys are only kept in Options.
+func WithOptionsLine() Option {
+	return func(e *Extender) {
+		e.optionsLine = true
+	}
+}
+
+// WithTerminators configures how fenced admonitions end, e.g. only with a
+// fence of the same length or "!!! end", and whether missing closing fences
+// fail the conversion:
+//
+//	admonitions.WithTerminators(admonitions.Terminators{
+//		ExactLength:  true,
+//		Unterminated: admonitions.UnterminatedError,
+//	})
+func WithTerminators(terminators Terminators) Option {
+	return func(e *Extender) {
+		e.terminators = terminators
+	}
+}
+
+// WithPandocDivs parses Pandoc fenced divs of known admonition types, the
+// title in a title attribute:
+//
+//	::: {.warning title="Careful"}
+//	This is the body.
+//	:::
+//
+// Divs of other classes, e.g. "::: {.columns}", are left alone.
+func WithPandocDivs() Option {
+	return func(e *Extender) {
+		e.pandoc = true
+	}
+}
+
+// WithInlineAdmonitions parses short callouts within sentences, rendered as
+// <span class="admonition-inline adm-tip"> or as Confluence status macros:
+//
+//	Edit the file [!tip: remember to save] and reload.
+//
+// The class is the name in lowercase, the text is taken as written.
+func WithInlineAdmonitions() Option {
+	return func(e *Extender) {
+		e.inline = true
+	}
+}
+
+// WithReStructuredText parses the admonition directives of
+// reStructuredText and Sphinx, e.g. for documentation migrating from Sphinx:
+//
+//	.. warning:: Don't run this in production.
+//
+//	   The body, indented deeper than the directive.
+//
+// "note", "tip", "warning" and the other directives of docutils and Sphinx
+// become admonitions of their class, titled like in Sphinx. The generic
+// ".. admonition:: Title" takes its type from its :class: option, note if
+// there is none, and :name: sets the id.
+func WithReStructuredText() Option {
+	return func(e *Extender) {
+		e.rst = true
+	}
+}
+
+// WithDirectives parses fenced directives as used by Docusaurus and
+// remark-directive as admonitions as well, with the same tags made of colons:
+//
+//	:::warning[Careful]
+//	This is the body.
+//	:::
+func WithDirectives() Option {
+	return func(e *Extender) {
+		e.directives = true
+	}
+}
+
+// WithBlockQuoteAdmonitions turns classified blockquotes into admonitions,
+// e.g. GitHub alerts:
+//
+//	> [!TIP]
+//	> This becomes an admonition of class tip
+//
+// Blockquotes classified by legacy keywords are only turned into admonitions
+// if the keyword is their leading word, see WithKeywordsAnywhere. end decides where the admonition ends, the rest of the blockquote stays a
+// blockquote.
+func WithBlockQuoteAdmonitions(end BlockQuoteEnd) Option {
+	return func(e *Extender) {
+		e.blockQuotes = true
+		e.blockQuoteEnd = end
+	}
+}
+
+// WithBoldLabels strips bold labels like "**Warning:**" from the start of
+// blockquotes turned into admonitions by WithBlockQuoteAdmonitions, as long as
+// the label matches the type of the quote:
+//
+//	> **Warning:** Don't touch this.
+//
+// policy decides whether the rest of the line stays in the body or becomes
+// the title.
+func WithBoldLabels(policy BoldLabel) Option {
+	return func(e *Extender) {
+		e.boldLabels = true
+		e.boldLabel = policy
+	}
+}
+
+// WithCustomAlerts classifies blockquotes with alert markers of other types
+// than GitHub's, e.g. "[!DANGER]" or "[!BUG]", as Custom. As admonitions
+// their class is the type in lowercase, "adm-bug", and AlertType keeps it as
+// written. Map them to the rendering of other classes with WithKinds.
+func WithCustomAlerts() Option {
+	return func(e *Extender) {
+		e.customAlerts = true
+	}
+}
+
+// WithCaseSensitiveMarkers classifies blockquotes by alert markers only if
+// the type is written in uppercase, "[!NOTE]" but not "[!note]" or
+// "[!Note]", which stay plain blockquotes. This applies to WithCustomAlerts
+// as well. Without it, markers match regardless of case.
+func WithCaseSensitiveMarkers() Option {
+	return func(e *Extender) {
+		e.caseSensitive = true
+	}
+}
+
+// WithStrictAlertMarkers classifies blockquotes by alert markers only if they
+// are written exactly as GitHub requires, "[!NOTE]" but not "[! NOTE ]" or
+// "[!NOTE]:", which stay plain blockquotes like on GitHub. Without it, spaces
+// within the brackets and a colon following them are tolerated.
+func WithStrictAlertMarkers() Option {
+	return func(e *Extender) {
+		e.strictMarkers = true
+	}
+}
+
+// WithClassificationScope restricts where legacy keywords like "note" or
+// "warn" classify blockquotes, e.g. to ClassifyLeadingWord so "> **Note:**
+// Take care" is still a note while "> All fine. Take note of this." stays a
+// quote. Defaults to ClassifyFirstBlock, which finds them anywhere in the
+// first line.
+func WithClassificationScope(scope ClassificationScope) Option {
+	return func(e *Extender) {
+		e.classificationScope = scope
+	}
+}
+
+// WithKeywordsAnywhere turns blockquotes classified by a legacy keyword
+// anywhere in their first line into admonitions with
+// WithBlockQuoteAdmonitions, e.g. "> Take note of this" as a note. Without
+// it, only alert markers and keywords leading the blockquote convert it, e.g.
+// "> Note: ...", as words like "multiple" contain keywords as well.
+func WithKeywordsAnywhere() Option {
+	return func(e *Extender) {
+		e.keywordsAnywhere = true
+	}
+}
+
+// WithStepAlerts classifies blockquotes with markers like "[!STEP 3]" as
+// Step, the number becoming the data-step attribute and the title "Step 3":
+//
+//	> [!STEP 3]
+//	> Run the installer.
+//
+// pattern matches the marker name without "[!" and "]" and captures the number
+// in its first group, nil means DefaultStepPattern.
+func WithStepAlerts(pattern *regexp.Regexp) Option {
+	return func(e *Extender) {
+		if pattern == nil {
+			pattern = DefaultStepPattern
+		}
+		e.steps = pattern
+	}
+}
+
+// WithExactMarkers keeps the first line of classified blockquotes as
+// written, even if extensions like extension.Typographer replaced quotes or
+// dashes in it, so markers and titles render byte-exact. Titles of "!!!"
+// admonitions are never typographed.
+func WithExactMarkers() Option {
+	return func(e *Extender) {
+		e.exactMarkers = true
+	}
+}
+
+// WithDepthStyle sets style="--adm-depth: N" on admonitions nested N levels
+// deep, for stylesheets to indent or fade them progressively:
+//
+//	.admonition { margin-left: calc(var(--adm-depth, 0) * 1em); }
+func WithDepthStyle() Option {
+	return func(e *Extender) {
+		e.config.DepthStyle = true
+	}
+}
+
+// WithCompactTitleOnly renders admonitions consisting of nothing but their
+// marker line, e.g. "> [!TIP] See the FAQ", as the title alone, without an
+// empty body, and adds the class adm-title-only.
+func WithCompactTitleOnly() Option {
+	return func(e *Extender) {
+		e.config.CompactTitleOnly = true
+	}
+}
+
+// WithContainers restricts admonitions to blocks of the given kinds, e.g.
+// ast.KindDocument for the top level only. Admonitions directly in another
+// block, e.g. a list item or another admonition, are rendered as plain
+// blockquotes with their title in bold. report receives an error for each, with
+// nil the first one fails the conversion. With WithFailFast they fail the
+// conversion, too.
+func WithContainers(report func(error), kinds ...ast.NodeKind) Option {
+	return func(e *Extender) {
+		e.containers = map[ast.NodeKind]bool{}
+		for _, kind := range kinds {
+			e.containers[kind] = true
+		}
+		e.onMisplaced = report
+	}
+}
+
+// WithConflictCheck reports node renderers that render blockquotes or
+// admonitions with the same priority as another renderer. The check runs once
+// all extensions have been added, before the first document is rendered.
+// report receives every conflict, with nil Convert fails with the first one.
+func WithConflictCheck(report func(Conflict)) Option {
+	return func(e *Extender) {
+		e.checkConflicts = true
+		e.onConflict = report
+	}
+}
@@ -0,0 +1,98 @@
+package admonitions
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// optionsLine matches a line of key=value options, values with spaces quoted:
+// `icon=rocket open=true color="rgb(139 0 0)"`
+var optionsLine = regexp.MustCompile(`^[ \t]*(?:[A-Za-z][\w-]*=(?:"[^"\n]*"|[^\s"]+)[ \t]*)+$`)
+
+// lineOption matches one option of an optionsLine
+var lineOption = regexp.MustCompile(`([A-Za-z][\w-]*)=(?:"([^"\n]*)"|([^\s"]+))`)
+
+// cssColor matches the colors the color option may set, named ones, hex
+// ones and rgb(), rgba(), hsl() and hsla(), e.g. "#ff8c00". Anything else
+// could end the declaration and add others to the style attribute.
+var cssColor = regexp.MustCompile(`^(?i:[a-z]+|#(?:[0-9a-f]{3,4}|[0-9a-f]{6}|[0-9a-f]{8})|(?:rgba?|hsla?)\((?:[\d\s.,%/+-]|deg|g?rad|turn)*\))$`)
+
+// optionsLineTransformer moves a line of options right after the opening line
+// of admonitions into their Options
+type optionsLineTransformer struct{}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *optionsLineTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*Admonition); ok && entering {
+			takeOptionsLine(n, source)
+		}
+		return ast.WalkContinue, nil
+	})
+}
+
+// takeOptionsLine removes the options line from the body of n, if it starts
+// with one on the line after the opener, and applies it: open sets the
+// collapsible attribute and color the CSS custom property --adm-color, if it
+// is a color
+func takeOptionsLine(n *Admonition, source []byte) {
+	paragraph, ok := n.FirstChild().(*ast.Paragraph)
+	if !ok || n.Opener.Len() == 0 {
+		return
+	}
+	first, ok := paragraph.FirstChild().(*ast.Text)
+	if !ok || lineAt(source, first.Segment.Start) != lineAt(source, n.Opener.Start)+1 {
+		return
+	}
+	line := source[first.Segment.Start:]
+	if end := bytes.IndexByte(line, '\n'); end >= 0 {
+		line = line[:end]
+	}
+	if !optionsLine.Match(line) {
+		return
+	}
+
+	takeLine(paragraph, source)
+	if paragraph.FirstChild() == nil {
+		n.RemoveChild(n, paragraph)
+	}
+	n.Options = map[string]string{}
+	for _, match := range lineOption.FindAllSubmatch(line, -1) {
+		value := match[2]
+		if value == nil {
+			value = match[3]
+		}
+		n.Options[string(match[1])] = string(value)
+	}
+
+	switch strings.ToLower(n.Options["open"]) {
+	case "true":
+		n.SetAttribute(collapseAttribute, []byte("open"))
+	case "false":
+		n.SetAttribute(collapseAttribute, []byte("closed"))
+	}
+	if color, ok := n.Options["color"]; ok && cssColor.MatchString(color) {
+		style := []byte("--adm-color: " + color)
+		if old, ok := n.AttributeString("style"); ok {
+			if value := bytes.TrimRight(attributeBytes(old), "; "); len(value) > 0 {
+				style = append(append(append([]byte{}, value...), "; "...), style...)
+			}
+		}
+		n.SetAttributeString("style", style)
+	}
+}
+
+// iconName returns the class whose icon n is rendered with, the icon option
+// of its options line if there is one
+func (n *Admonition) iconName() string {
+	if icon, ok := n.Options["icon"]; ok {
+		return icon
+	}
+	return string(n.AdmonitionClass)
+}
@@ -0,0 +1,89 @@
+package admonitions
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// pandocClass matches the bare class of a Pandoc fenced div, e.g. "warning"
+// in "::: warning", with optional closing colons
+var pandocClass = regexp.MustCompile(`^([\w-]+)[ \t]*:*$`)
+
+// titleAttribute is the attribute Pandoc fenced divs carry their title in
+var titleAttribute = []byte("title")
+
+// isKnownType reports whether class is a type of admonition divs of other
+// kinds shouldn't be taken for, i.e. one with a Confluence macro or of kinds
+func isKnownType(class string, kinds map[string]Kind) bool {
+	if _, ok := confluenceMacros[strings.ToLower(class)]; ok {
+		return true
+	}
+	_, ok := kinds[class]
+	return ok
+}
+
+// pandocFence returns what follows the colons if line starts with a fence
+// of a fenced div, e.g. "{.columns}" for "::: {.columns}" and nothing for a
+// closing ":::"
+func pandocFence(line []byte) ([]byte, bool) {
+	i := 0
+	for ; i < len(line) && line[i] == ':'; i++ {
+	}
+	return bytes.TrimSpace(line[i:]), i >= 3
+}
+
+// parsePandocOpeningLine returns the admonition the rest of the opening line
+// of a Pandoc fenced div opens, e.g. `{.warning #id title="Careful"}` or
+// `warning`, or nil if none of its classes is a known type. The first known
+// class is the type, the others are kept as classes. Like in MkDocs, the
+// title defaults to the type.
+func parsePandocOpeningLine(rest []byte, kinds map[string]Kind) *Admonition {
+	var attrs parser.Attributes
+	if match := pandocClass.FindSubmatch(rest); match != nil {
+		attrs = parser.Attributes{{Name: []byte("class"), Value: match[1]}}
+	} else {
+		reader := text.NewReader(rest)
+		var ok bool
+		if attrs, ok = parseAttributes(reader); !ok {
+			return nil
+		}
+		if after, _ := reader.PeekLine(); len(bytes.Trim(after, " \t:")) > 0 {
+			return nil
+		}
+	}
+
+	node := NewAdmonition()
+	var others parser.Attributes
+	var classes [][]byte
+	for _, attr := range attrs {
+		switch {
+		case bytes.Equal(attr.Name, []byte("class")):
+			for _, class := range bytes.Fields(attributeBytes(attr.Value)) {
+				if node.AdmonitionClass == nil && isKnownType(string(class), kinds) {
+					node.AdmonitionClass = class
+				} else {
+					classes = append(classes, class)
+				}
+			}
+		case bytes.Equal(attr.Name, titleAttribute):
+			node.Title = attributeBytes(attr.Value)
+		default:
+			others = append(others, attr)
+		}
+	}
+	if node.AdmonitionClass == nil {
+		return nil
+	}
+	if node.Title == nil {
+		node.Title = capitalize(node.AdmonitionClass)
+	}
+	if len(classes) > 0 {
+		others = append(others, parser.Attribute{Name: []byte("class"), Value: bytes.Join(classes, []byte(" "))})
+	}
+	setAttributes(node, others)
+	return node
+}
@@ -11,6 +11,21 @@ import (
 )
 
 type admonitionParser struct {
+	randomIDs bool // whether unclosed admonitions keep a random data-admonition
+	char      byte // the character of the tags, '!' if 0 and ':' for directives
+
+	pandoc bool            // whether only Pandoc fenced divs of known types are parsed
+	kinds  map[string]Kind // custom types Pandoc divs may be of
+
+	terminators Terminators // how admonitions end
+}
+
+// tagChar returns the character the tags of b are made of
+func (b *admonitionParser) tagChar() byte {
+	if b.char == 0 {
+		return '!'
+	}
+	return b.char
 }
 
 var defaultAdmonitionParser = &admonitionParser{}
@@ -29,23 +44,42 @@ type admonitionData struct {
 	node              ast.Node // The node of the admonition
 	contentIndent     int      // The indentation of the content relative to the previous admonition block. The first line of the content is taken as its indentation. If you want an admonition with just a code block you need to use backticks
 	contentHasStarted bool     // Only used as an indicator if contentIndent has been set already
+	divs              int      // The Pandoc divs of other classes open within the admonition, their fences don't close it
 }
 
 var admonitionInfoKey = parser.NewContextKey()
 
+// admonitionCountKey counts the admonitions opened so far, numbering their
+// internal IDs
+var admonitionCountKey = parser.NewContextKey()
+
+// newID returns the internal ID of an admonition being opened. It is unique
+// within the document and, unless randomIDs is set, the same on every run.
+func (b *admonitionParser) newID(pc parser.Context) string {
+	if b.randomIDs {
+		return genRandomString(24)
+	}
+	count, _ := pc.Get(admonitionCountKey).(int)
+	pc.Set(admonitionCountKey, count+1)
+	return fmt.Sprintf("%s%d", openIDPrefix, count)
+}
+
+// openIDPrefix starts the internal IDs, which are replaced once admonitions
+// are closed
+var openIDPrefix = []byte("open-")
+
 func (b *admonitionParser) Trigger() []byte {
-	return []byte{'!'}
+	return []byte{b.tagChar()}
 }
 
 func (b *admonitionParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
-	line, _ := reader.PeekLine()
+	line, segment := reader.PeekLine()
 	pos := pc.BlockOffset()
-	if pos < 0 || line[pos] != '!' {
+	if pos < 0 || line[pos] != b.tagChar() {
 		return nil, parser.NoChildren
 	}
 	findent := pos
 
-	// currently useless
 	admonitionChar := line[pos]
 	i := pos
 	for ; i < len(line) && line[i] == admonitionChar; i++ {
@@ -68,7 +102,7 @@ func (b *admonitionParser) Open(parent ast.Node, reader text.Reader, pc parser.C
 	left := i + util.TrimLeftSpaceLength(rest)
 	right := len(line) - 1 - util.TrimRightSpaceLength(rest)
 
-	if left >= right {
+	if left >= right || b.terminators.isEndFence(rest) {
 		// As above:
 		// If there are no attributes we can't create a div because we won't know
 		// if a "!!!" ends the last admonition or opens a new one
@@ -77,9 +111,35 @@ func (b *admonitionParser) Open(parent ast.Node, reader text.Reader, pc parser.C
 
 	// ========================================================================== //
 	// 	With attributes we construct the node
-	node := parseOpeningLine(reader, left)
-	admonitionID := genRandomString(24)
+	severity := 0
+	if !b.pandoc {
+		var length int
+		severity, length = severityPrefix(line[left : right+1])
+		left += length
+	}
+
+	var node *Admonition
+	if b.pandoc {
+		if node = parsePandocOpeningLine(line[left:right+1], b.kinds); node == nil {
+			return nil, parser.NoChildren
+		}
+		reader.Advance(right + 1)
+	} else {
+		node = parseOpeningLine(reader, left, admonitionChar == ':')
+	}
+	node.Opener = text.NewSegment(segment.Start, segment.Stop)
+	markerStop := left + len(node.AdmonitionClass)
+	if b.pandoc {
+		markerStop = right + 1
+	}
+	node.setMarker(reader.Source(), segment.Start+pos, segment.Start+markerStop)
+	// The end of the body is set once the admonition is closed
+	node.Body = text.NewSegment(segment.Stop, -1)
+	admonitionID := b.newID(pc)
 	node.SetAttributeString("data-admonition", []byte(admonitionID))
+	if node.Severity = severity; severity > 0 {
+		node.SetAttributeString(severityAttribute, []byte(fmt.Sprint(severity)))
+	}
 
 	fdata := &admonitionData{
 		ID:                admonitionID,
@@ -106,7 +166,7 @@ func (b *admonitionParser) Open(parent ast.Node, reader text.Reader, pc parser.C
 	line, _ = reader.PeekLine()
 	w, pos := util.IndentWidth(line, reader.LineOffset())
 
-	if close, _ := hasClosingTag(line, w, pos, fdata); w < fdata.indent || close {
+	if close, _ := b.hasClosingTag(line, w, pos, fdata); w < fdata.indent || close || node.IsRaw() {
 		return node, parser.NoChildren
 	}
 
@@ -115,9 +175,9 @@ func (b *admonitionParser) Open(parent ast.Node, reader text.Reader, pc parser.C
 
 // Parse the opening line for
 // * admonition class
-// * admonition title
+// * admonition title, in brackets for directives: ":::note[Title]"
 // * attributes
-func parseOpeningLine(reader text.Reader, left int) *Admonition {
+func parseOpeningLine(reader text.Reader, left int, directive bool) *Admonition {
 	node := NewAdmonition()
 	reader.Advance(left)
 
@@ -127,7 +187,7 @@ func parseOpeningLine(reader text.Reader, left int) *Admonition {
 	// ========================================================================== //
 	// 	find class
 	endClass := 0
-	for ; endClass < remainingLength && remainingLine[endClass] != ' ' && remainingLine[endClass] != '{'; endClass++ {
+	for ; endClass < remainingLength && remainingLine[endClass] != ' ' && !isAttributesStart(remainingLine, endClass) && !(directive && remainingLine[endClass] == '['); endClass++ {
 	}
 	if endClass > 0 {
 		node.AdmonitionClass = remainingLine[0:endClass]
@@ -137,9 +197,14 @@ func parseOpeningLine(reader text.Reader, left int) *Admonition {
 	// 	find title
 	startTitle := endClass + util.TrimLeftSpaceLength(remainingLine[endClass:])
 	endTitle := startTitle
-	for ; endTitle < remainingLength && remainingLine[endTitle] != '{'; endTitle++ {
+	if label, ok := directiveLabel(remainingLine[startTitle:]); ok && directive && startTitle < remainingLength {
+		node.Title = label
+		endTitle = startTitle + len(label) + 2
+	} else {
+		for ; endTitle < remainingLength && !isAttributesStart(remainingLine, endTitle); endTitle++ {
+		}
 	}
-	if endTitle > startTitle {
+	if endTitle > startTitle && node.Title == nil {
 		endTitle = endTitle - util.TrimRightSpaceLength(remainingLine[startTitle:endTitle])
 		if endTitle > startTitle {
 			node.Title = remainingLine[startTitle:endTitle]
@@ -154,32 +219,124 @@ func parseOpeningLine(reader text.Reader, left int) *Admonition {
 
 	// ========================================================================== //
 	// 	find attributes
-	hasClass := false
-	admClass := bytes.Join([][]byte{[]byte("admonition adm-"), node.AdmonitionClass}, []byte(""))
 
-	attrs, ok := parser.ParseAttributes(reader)
+	// ParseAttributes skips spaces, including the line break, before looking
+	// for a "{". On failure it resets the reader with SetPosition, which keeps
+	// the line peeked on the way, so there is no looking beyond this line.
+	var attrs parser.Attributes
+	ok := false
+	if endTitle < remainingLength {
+		attrs, ok = parseAttributes(reader)
+		reader.Advance(0)
+	}
 
-	if ok {
-		for _, attr := range attrs {
-			oldVal := attr.Value.([]byte)
-			var val []byte
+	if !ok {
+		attrs = nil
+	}
+	setAttributes(node, attrs)
 
-			if bytes.Equal(attr.Name, []byte("class")) {
-				hasClass = true
-				val = bytes.Join([][]byte{admClass, oldVal}, []byte(" "))
-			} else {
-				val = oldVal
-			}
+	return node
+}
+
+// setAttributes sets attrs on node, classes in addition to the class of the
+// admonition
+func setAttributes(node *Admonition, attrs parser.Attributes) {
+	hasClass := false
+	admClass := admonitionClassAttribute(node.AdmonitionClass)
 
-			node.SetAttribute(attr.Name, val)
+	for _, attr := range attrs {
+		oldVal := attributeBytes(attr.Value)
+		var val []byte
+
+		if bytes.Equal(attr.Name, []byte("class")) {
+			hasClass = true
+			val = bytes.Join([][]byte{admClass, oldVal}, []byte(" "))
+		} else {
+			val = oldVal
 		}
+
+		node.SetAttribute(attr.Name, val)
 	}
 
 	if !hasClass {
 		node.SetAttribute([]byte("class"), admClass)
 	}
+}
 
-	return node
+// titleAttributes splits attributes like "{#id .class}" off the end of
+// title, as written after blockquote markers: "> [!NOTE] Title {#id}"
+func titleAttributes(title []byte) ([]byte, parser.Attributes) {
+	start := bytes.LastIndexByte(title, '{')
+	if start < 0 {
+		return title, nil
+	}
+	reader := text.NewReader(title[start:])
+	attrs, ok := parseAttributes(reader)
+	if !ok {
+		return title, nil
+	}
+	if rest, _ := reader.PeekLine(); len(bytes.TrimSpace(rest)) > 0 {
+		return title, nil
+	}
+	return bytes.TrimSpace(title[:start]), attrs
+}
+
+// directiveLabel returns the label in brackets line starts with, e.g. the
+// title of ":::note[Title]". Brackets within the label have to be balanced.
+func directiveLabel(line []byte) ([]byte, bool) {
+	if len(line) == 0 || line[0] != '[' {
+		return nil, false
+	}
+	depth := 0
+	for i, c := range line {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return line[1:i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// attributeBytes returns an attribute value as text. goldmark keeps values
+// like true, 1 or [a, b] as bool, float64 and []interface{}, but everything
+// reading admonition attributes expects []byte.
+func attributeBytes(value interface{}) []byte {
+	switch v := value.(type) {
+	case []byte:
+		return v
+	case nil:
+		return []byte{}
+	case []interface{}:
+		values := make([][]byte, len(v))
+		for i, item := range v {
+			values[i] = attributeBytes(item)
+		}
+		return bytes.Join(values, []byte(" "))
+	}
+	return []byte(fmt.Sprint(value))
+}
+
+// isAttributesStart reports whether the attributes start at line[i]. "{{"
+// opens a placeholder instead, see WithVars.
+func isAttributesStart(line []byte, i int) bool {
+	if line[i] != '{' {
+		return false
+	}
+	if i+1 < len(line) && line[i+1] == '{' {
+		return false
+	}
+	return i == 0 || line[i-1] != '{'
+}
+
+// admonitionClassAttribute returns the class attribute every admonition of the
+// given class carries, e.g. "admonition adm-note"
+func admonitionClassAttribute(class []byte) []byte {
+	return bytes.Join([][]byte{[]byte("admonition adm-"), class}, []byte(""))
 }
 
 func (b *admonitionParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
@@ -194,24 +351,26 @@ func (b *admonitionParser) Continue(node ast.Node, reader text.Reader, pc parser
 
 	// ========================================================================== //
 	// 	Get admonition for current admonition
-	rawdata := pc.Get(admonitionInfoKey)
-	fdataMap := rawdata.([]*admonitionData)
-
-	// This should not happen
-	if len(fdataMap) == 0 {
-		fmt.Printf("we're in an admonition block but have no state data. This should not happen")
-		return parser.Close
-	}
+	fdataMap, _ := pc.Get(admonitionInfoKey).([]*admonitionData)
 
 	var fdata *admonitionData
 	var flevel int
 	for flevel = 0; flevel < len(fdataMap); flevel++ {
-		fdata = fdataMap[flevel]
-		if fdata.ID == admonitionID {
+		if fdataMap[flevel].ID == admonitionID {
+			fdata = fdataMap[flevel]
 			break
 		}
 	}
 
+	// Without state the admonition was closed by indentation, but goldmark
+	// kept it open because the line continued its last paragraph lazily, as
+	// in list items. It ends before the next line unless that continues too.
+	if fdata == nil {
+		_, segment := reader.PeekLine()
+		node.(*Admonition).Body.Stop = segment.Start
+		return parser.Close
+	}
+
 	// ========================================================================== //
 	// 	Set indentation level if it hasn't been set yet
 
@@ -231,8 +390,28 @@ func (b *admonitionParser) Continue(node ast.Node, reader text.Reader, pc parser
 	// * Either the indentation is below the indentation of the opening tags
 	// * or it is at the level of the opening tags but the content was indented
 	// * or there is a closing tag and we're in the deepest admonition block
-	close, newline := hasClosingTag(line, w, pos, fdata)
+	close, newline := b.hasClosingTag(line, w, pos, fdata)
+	if b.pandoc && flevel == len(fdataMap)-1 {
+		// Any fence of three colons closes the innermost div, Pandoc doesn't
+		// match lengths
+		rest, fence := pandocFence(line[pos:])
+		switch {
+		case fence && len(rest) > 0 && !b.terminators.isEndFence(rest) && parsePandocOpeningLine(rest, b.kinds) == nil:
+			fdata.divs++
+		case fence && len(rest) == 0 && fdata.divs > 0:
+			fdata.divs--
+			close = false
+		case fence && (len(rest) == 0 || b.terminators.isEndFence(rest)):
+			close = true
+			newline = 0
+			if line[len(line)-1] == '\n' {
+				newline = 1
+			}
+		}
+	}
 	if close && flevel == len(fdataMap)-1 {
+		node.(*Admonition).Body.Stop = segment.Start
+		node.(*Admonition).Closer = text.NewSegment(segment.Start, segment.Stop)
 		reader.Advance(segment.Stop - segment.Start - newline + segment.Padding)
 
 		node.SetAttributeString("data-admonition", []byte(fmt.Sprint(flevel)))
@@ -248,6 +427,8 @@ func (b *admonitionParser) Continue(node ast.Node, reader text.Reader, pc parser
 			(w < fdata.indent || (w == fdata.indent && w < fdata.contentIndent))
 
 	if indentClose {
+		n := node.(*Admonition)
+		n.Body.Stop = bodyStop(reader.Source(), n.Body.Start, segment.Start)
 		node.SetAttributeString("data-admonition", []byte(fmt.Sprint(flevel)))
 
 		fdataMap = fdataMap[:flevel]
@@ -256,7 +437,23 @@ func (b *admonitionParser) Continue(node ast.Node, reader text.Reader, pc parser
 		return parser.Close
 	}
 
+	// The body of raw admonitions is kept as is
+	if node.IsRaw() {
+		node.Lines().Append(segment)
+		newline := 0
+		if len(line) > 0 && line[len(line)-1] == '\n' {
+			newline = 1
+		}
+		reader.Advance(segment.Stop - segment.Start - newline + segment.Padding)
+		return parser.Continue | parser.NoChildren
+	}
+
 	if fdata.contentIndent > 0 {
+		// the indentation is in columns, a tab may stand for several spaces
+		if pos, padding := util.IndentPosition(line, reader.LineOffset(), fdata.contentIndent); pos >= 0 {
+			reader.AdvanceAndSetPadding(pos, padding)
+			return parser.Continue | parser.HasChildren
+		}
 		dontJumpLineEnd := segment.Stop - segment.Start - 1
 		if fdata.contentIndent < dontJumpLineEnd {
 			dontJumpLineEnd = fdata.contentIndent
@@ -269,6 +466,50 @@ func (b *admonitionParser) Continue(node ast.Node, reader text.Reader, pc parser
 }
 
 func (b *admonitionParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {
+	// Admonitions still open at the end of the document get their level like
+	// closed ones. Inner admonitions are closed first.
+	if !b.randomIDs {
+		b.closeLevel(node, pc)
+	}
+
+	// Unless Continue has closed the admonition, it spans up to here
+	if n := node.(*Admonition); n.Body.Stop < 0 {
+		n.unterminated = true
+		_, segment := reader.Position()
+		n.Body.Stop = segment.Start
+		if l := len(reader.Source()); n.Body.Stop > l {
+			n.Body.Stop = l
+		}
+		if n.Body.Stop < n.Body.Start {
+			n.Body.Stop = n.Body.Start
+		}
+	}
+}
+
+// closeLevel replaces the internal ID of node with its level, unless Continue
+// has done so already. That's the case at the end of the document and when
+// the enclosing admonition ends first.
+func (b *admonitionParser) closeLevel(node ast.Node, pc parser.Context) {
+	rawAdmonitionID, ok := node.AttributeString("data-admonition")
+	if !ok || !bytes.HasPrefix(rawAdmonitionID.([]byte), openIDPrefix) {
+		return
+	}
+
+	flevel := 0
+	for p := node.Parent(); p != nil; p = p.Parent() {
+		if _, ok := p.(*Admonition); ok {
+			flevel++
+		}
+	}
+	node.SetAttributeString("data-admonition", []byte(fmt.Sprint(flevel)))
+
+	fdataMap, _ := pc.Get(admonitionInfoKey).([]*admonitionData)
+	for i, fdata := range fdataMap {
+		if fdata.ID == string(rawAdmonitionID.([]byte)) {
+			pc.Set(admonitionInfoKey, fdataMap[:i])
+			return
+		}
+	}
 }
 
 func (b *admonitionParser) CanInterruptParagraph() bool {
@@ -279,7 +520,10 @@ func (b *admonitionParser) CanAcceptIndentedLine() bool {
 	return false
 }
 
-func hasClosingTag(line []byte, w int, pos int, fdata *admonitionData) (bool, int) {
+// hasClosingTag reports whether line closes the admonition of fdata: a fence
+// at its indentation as long as the opening one, or longer unless
+// terminators require the exact length, optionally followed by "end"
+func (b *admonitionParser) hasClosingTag(line []byte, w int, pos int, fdata *admonitionData) (bool, int) {
 	// else, check for the correct number of closing chars and provide the info
 	// necessary to advance the reader
 	if w == fdata.indent {
@@ -287,8 +531,12 @@ func hasClosingTag(line []byte, w int, pos int, fdata *admonitionData) (bool, in
 		for ; i < len(line) && line[i] == fdata.char; i++ {
 		}
 		length := i - pos
+		lengthOK := length >= fdata.length
+		if b.terminators.ExactLength {
+			lengthOK = length == fdata.length
+		}
 
-		if length >= fdata.length && util.IsBlank(line[i:]) {
+		if lengthOK && (util.IsBlank(line[i:]) || b.terminators.isEndFence(line[i:])) {
 			newline := 1
 			if line[len(line)-1] != '\n' {
 				newline = 0
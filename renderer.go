@@ -1,8 +1,13 @@
 package admonitions
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"regexp"
+	"sync"
+	"time"
 
 	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/renderer"
@@ -12,12 +17,206 @@ import (
 
 // A Config struct has configurations for the HTML based renderers.
 type Config struct {
-	Writer    html.Writer
+	// Writer writes the text of titles, escaped with util.EscapeHTML if nil.
+	// html.WithWriter passed to goldmark sets it.
+	Writer html.Writer
+
+	// Deprecated: HardWraps has never been used and is only kept so existing
+	// struct literals compile.
 	HardWraps bool
-	XHTML     bool
-	Unsafe    bool
+
+	// XHTML is set by html.WithXHTML passed to goldmark as well, so bodies
+	// and wrappers close void elements alike. Unsafe writes raw admonitions,
+	// only set by WithUnsafe.
+	XHTML  bool
+	Unsafe bool
+
+	Target Target // the output format, defaults to TargetHTML
+
+	// ComponentTag is the custom element admonitions are rendered as with
+	// TargetWebComponent. Defaults to doc-admonition, also if it isn't a
+	// valid custom element name.
+	ComponentTag string
+
+	// ConfluenceOutput decides whether Confluence output is a bare fragment
+	// or a complete XML document
+	ConfluenceOutput ConfluenceOutput
+
+	// ConfluenceParameters maps attributes of admonitions to the parameters
+	// of the Confluence macros they are rendered as, e.g. {"collapse":
+	// "collapse"}. Mapped icon and title parameters replace the default ones.
+	ConfluenceParameters map[string]string
+
+	// SeverityMacros maps the severities of admonitions to the Confluence
+	// macros they are rendered as, taking precedence over their classes
+	SeverityMacros map[int]string
+
+	// ConfluenceDiagramMacros maps the languages of fenced code blocks in
+	// admonitions to the Confluence macros they are passed to unmodified,
+	// e.g. {"mermaid": "mermaid-cloud"}. Other code blocks render as usual.
+	ConfluenceDiagramMacros map[string]string
+
+	// ConfluenceIcons configure the icons of the Confluence macros of
+	// classes, hiding them or showing an emoji in a panel macro. Other
+	// classes show the icon of their macro.
+	ConfluenceIcons map[string]ConfluenceIcon
+
+	// CompactThreshold is the maximum length of admonitions without a title
+	// and only a short paragraph that are rendered as Confluence status
+	// macros. 0 disables compact admonitions.
+	CompactThreshold int
+
+	TitleElement TitleElement // the element titles are rendered as
+	TitleLevel   int          // the heading level with TitleHeading, 0 means automatic
+
+	// TitleFunc computes the title of every admonition when it is rendered,
+	// replacing the authored one, which is n.Title
+	TitleFunc func(n *Admonition) string
+
+	// TitleFilter rewrites every title right before it is rendered, e.g. to
+	// enforce terminology
+	TitleFilter func(title string) string
+
+	// Responsive renders the body of every admonition once, in an always open
+	// variant, and writes a copy of it into a <details> element, so CSS media
+	// queries can pick one per screen size
+	Responsive bool
+
+	// CollapseScript writes CollapseScript into every document with
+	// collapsible admonitions, persisting their open state
+	CollapseScript bool
+
+	// WordLimit collapses the blocks of bodies beyond this many words into a
+	// "Show more" <details> element. 0 shows all of the body.
+	WordLimit int
+
+	// Figures renders admonitions whose only content is an image as a
+	// <figure> with the title as <figcaption>
+	Figures bool
+
+	// ApprovalFooter renders the approved-by attribute in a footer line
+	ApprovalFooter bool
+
+	// Flags are evaluated against the if attribute of admonitions, hiding the
+	// ones whose condition doesn't hold. Conditions are ignored if nil.
+	Flags map[string]bool
+
+	// Icons are rendered in front of the titles of admonitions of their
+	// class. With IconSprite every icon is written once per document in a
+	// hidden SVG sprite and referenced with <use>.
+	Icons      map[string]Icon
+	IconSprite bool
+
+	// IconURLs maps classes to the URLs of external icons, which are lazily
+	// loaded <img> elements instead of inline SVG. Classes without an entry
+	// use IconBaseURL + class + ".svg" if IconBaseURL is set.
+	IconURLs    map[string]string
+	IconBaseURL string
+
+	// IconChains resolve the icons of classes from a file to an Iconify icon
+	// to a Unicode symbol, whichever is available first. IconAvailable
+	// decides whether files and Iconify URLs are, e.g. false for URLs when
+	// building offline. If nil, local files have to exist and URLs are
+	// assumed to load.
+	IconChains    map[string]IconChain
+	IconAvailable func(src string) bool
+
+	// Abbreviations maps terms to their expansions, which are rendered as
+	// <abbr> in the titles and bodies of admonitions. NoTitleAbbreviations
+	// keeps titles as they are, e.g. if the titles are styled already.
+	Abbreviations        map[string]string
+	NoTitleAbbreviations bool
+
+	// Expiry decides what happens to admonitions whose expires attribute
+	// lies in the past by Clock, time.Now if nil
+	Expiry Expiry
+	Clock  func() time.Time
+
+	// Stats collects how long rendering each admonition takes
+	Stats *Stats
+
+	// TicketLinks link references to tickets in the titles and bodies of
+	// admonitions
+	TicketLinks []TicketLink
+
+	// ScreenReaderLabels writes a visually hidden label like "Warning:" at the
+	// start of every admonition, taken from ScreenReaderTexts by class or
+	// derived from the class
+	ScreenReaderLabels bool
+	ScreenReaderTexts  map[string]string
+
+	// Kinds are custom classes inheriting the rendering of other classes
+	Kinds map[string]Kind
+
+	// Metadata writes the type and title of every admonition as JSON into a
+	// <script type="application/json"> at the start of its wrapper, for
+	// scripts enhancing admonitions without parsing the HTML
+	Metadata bool
+
+	// ClassScope is appended to the classes this package emits, e.g.
+	// "adm-title-docs", so documents embedded in the same page can be styled
+	// independently. Classes added by authors are kept.
+	ClassScope string
+
+	// ClassStrategy names the classes this package emits, e.g. BEMClasses.
+	// ClassScope is ignored if set.
+	ClassStrategy ClassStrategy
+
+	// Unwrap renders only the bodies of admonitions, without wrapper and
+	// title, e.g. for plain summaries or search snippets
+	Unwrap bool
+
+	// FailFast fails rendering on unexpected conditions instead of silently
+	// falling back, see WithFailFast
+	FailFast bool
+
+	// SourceMap adds the source lines to the wrapper of every admonition,
+	// e.g. data-source-lines="3-7", see SourceMap
+	SourceMap bool
+
+	// DepthStyle sets the CSS custom property --adm-depth on the wrappers of
+	// nested admonitions, style="--adm-depth: 2" two levels down, so
+	// stylesheets can indent or fade them progressively
+	DepthStyle bool
+
+	// CompactTitleOnly renders admonitions with nothing but a title, e.g.
+	// "> [!TIP] See the FAQ", without the empty body and with the class
+	// adm-title-only
+	CompactTitleOnly bool
+
+	// Restricted omits the body of raw admonitions even if Unsafe is set,
+	// see WithRestrictions
+	Restricted bool
 }
 
+// Target is the output format admonitions are rendered as
+type Target int
+
+const (
+	TargetHTML         Target = iota // divs with a title and a body, the default
+	TargetConfluence                 // Confluence storage format macros
+	TargetWebComponent               // custom elements, see Config.ComponentTag
+)
+
+// ConfluenceOutput is how Confluence storage format is packaged
+type ConfluenceOutput int
+
+const (
+	ConfluenceFragment ConfluenceOutput = iota // bare storage format as expected by body.storage, the default
+	ConfluencePage                             // an XML document with the ac and ri namespaces declared
+	ConfluenceWrapped                          // the fragment in a <div> declaring the ac and ri namespaces
+)
+
+// TitleElement is the HTML element the title of an admonition is rendered as
+type TitleElement int
+
+const (
+	TitleDiv       TitleElement = iota // <div class="adm-title">, the default
+	TitleParagraph                     // <p class="adm-title">
+	TitleSpan                          // <span class="adm-title">
+	TitleHeading                       // <hN class="adm-title">, see Config.TitleLevel
+)
+
 // HeadingAttributeFilter defines attribute names which heading elements can have
 var AdmonitionAttributeFilter = html.GlobalAttributeFilter
 
@@ -25,12 +224,73 @@ var AdmonitionAttributeFilter = html.GlobalAttributeFilter
 // nodes as (X)HTML.
 type Renderer struct {
 	Config
+
+	// Deprecated: LevelMap isn't read anymore. The classification of the
+	// blockquotes of a document is stored in its parser.Context, see
+	// BlockQuoteTypes.
 	LevelMap BlockQuoteLevelMap
+
+	markdown       renderer.Renderer // renders the children of admonitions itself, e.g. in Responsive mode
+	detached       detachedStates    // the renderStates of admonitions rendered without a document
+	availableIcons sync.Map          // whether the files of IconChains exist, by path
+
+	abbreviationPattern *regexp.Regexp   // matches the terms of Abbreviations, compiled by Extend
+	rendererConfig      *renderer.Config // the configuration checked for conflicts, see WithConflictCheck
+	checkConflicts      bool
+	onConflict          func(Conflict)        // receives the conflicts found
+	conflicts           []Conflict            // the conflicts found without onConflict, failing the rendering
+	renderedKinds       map[ast.NodeKind]bool // the kinds rendererConfig renders, set by RegisterFuncs, see FailFast
 }
 
 // RegisterFuncs implements NodeRenderer.RegisterFuncs .
 func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
-	reg.Register(KindAdmonition, r.renderAdmon)
+	if r.Stats != nil {
+		reg.Register(KindAdmonition, r.timed(r.renderAdmonition))
+	} else {
+		reg.Register(KindAdmonition, r.renderAdmonition)
+	}
+	reg.Register(KindKeys, r.renderKeys)
+	reg.Register(KindInlineAdmonition, r.renderInlineAdmonition)
+	reg.Register(KindTab, r.renderTab)
+	reg.Register(KindAbbreviation, r.renderAbbreviation)
+	reg.Register(KindProblem, r.renderProblem)
+	if r.Target == TargetConfluence && r.ConfluenceOutput != ConfluenceFragment {
+		reg.Register(ast.KindDocument, r.renderConfluencePage)
+	}
+	// By now every renderer has been added, unless the conflict check itself
+	// is asking
+	if _, recording := reg.(*kindRecorder); r.rendererConfig != nil && !recording {
+		if r.checkConflicts {
+			if r.conflicts = reportConflicts(r.rendererConfig, r.onConflict); len(r.conflicts) > 0 {
+				reg.Register(ast.KindDocument, r.renderConflict)
+			}
+		}
+		if r.FailFast {
+			r.renderedKinds = renderedKinds(r.rendererConfig)
+		}
+	}
+}
+
+// SetOption implements renderer.SetOptioner. goldmark hands it the renderer
+// options, e.g. html.WithXHTML, before the first document is rendered.
+// html.WithUnsafe is deliberately not taken over: it writes raw HTML in
+// bodies, which goldmark renders, but raw admonitions take WithUnsafe.
+func (r *Renderer) SetOption(name renderer.OptionName, value interface{}) {
+	var c html.Config
+	c.SetOption(name, value)
+	r.XHTML = r.XHTML || c.XHTML
+	if c.Writer != nil {
+		r.Writer = c.Writer
+	}
+}
+
+// writeText writes the text of a title with Writer, or escaped without one
+func (r *Renderer) writeText(w util.BufWriter, text []byte) {
+	if r.Writer != nil {
+		r.Writer.Write(w, text)
+		return
+	}
+	_, _ = w.Write(util.EscapeHTML(text))
 }
 
 // Define BlockQuoteType enum
@@ -42,10 +302,14 @@ const (
 	Warn
 	Tip
 	None
+	Step      // a stepped alert like "[!STEP 3]", see WithStepAlerts
+	Important // the GitHub alert "[!IMPORTANT]"
+	Caution   // the GitHub alert "[!CAUTION]"
+	Custom    // an alert of another type like "[!BUG]", see WithCustomAlerts
 )
 
 func (t BlockQuoteType) String() string {
-	return []string{"info", "note", "warning", "tip", "none"}[t]
+	return []string{"info", "note", "warning", "tip", "none", "step", "important", "caution", "custom"}[t]
 }
 
 type BlockQuoteLevelMap map[ast.Node]int
@@ -69,93 +333,228 @@ func LegacyBlockQuoteClassifier() BlockQuoteClassifier {
 	}
 }
 
+// GHAlertsBlockQuoteClassifier classifies the five GitHub alert types, each
+// as a type of its own: NOTE, TIP, IMPORTANT, WARNING and CAUTION
 func GHAlertsBlockQuoteClassifier() BlockQuoteClassifier {
 	return BlockQuoteClassifier{
 		patternMap: map[string]*regexp.Regexp{
-			"info": regexp.MustCompile(`(?i)^\!(note|important)`),
-			"note": regexp.MustCompile(`(?i)^\!warning`),
-			"warn": regexp.MustCompile(`(?i)^\!caution`),
-			"tip":  regexp.MustCompile(`(?i)^\!tip`),
+			"note":      regexp.MustCompile(`(?i)^\!note$`),
+			"tip":       regexp.MustCompile(`(?i)^\!tip$`),
+			"important": regexp.MustCompile(`(?i)^\!important$`),
+			"warn":      regexp.MustCompile(`(?i)^\!warning$`),
+			"caution":   regexp.MustCompile(`(?i)^\!caution$`),
 		},
 	}
 }
 
+// classifierTypes are the types a BlockQuoteClassifier tries in order, by
+// the key of their pattern
+var classifierTypes = []struct {
+	key string
+	t   BlockQuoteType
+}{
+	{"info", Info},
+	{"note", Note},
+	{"warn", Warn},
+	{"tip", Tip},
+	{"important", Important},
+	{"caution", Caution},
+}
+
 // ClassifyingBlockQuote compares a string against a set of patterns and returns a BlockQuoteType
 func (classifier BlockQuoteClassifier) ClassifyingBlockQuote(literal string) BlockQuoteType {
+	for _, c := range classifierTypes {
+		if pattern, ok := classifier.patternMap[c.key]; ok && pattern.MatchString(literal) {
+			return c.t
+		}
+	}
+	return None
+}
+
+// The classifiers used by classifyBlockQuote
+var (
+	legacyClassifier   = LegacyBlockQuoteClassifier()
+	ghAlertsClassifier = GHAlertsBlockQuoteClassifier()
+)
 
-	var t = None
-	switch {
-	case classifier.patternMap["info"].MatchString(literal):
-		t = Info
-	case classifier.patternMap["note"].MatchString(literal):
-		t = Note
-	case classifier.patternMap["warn"].MatchString(literal):
-		t = Warn
-	case classifier.patternMap["tip"].MatchString(literal):
-		t = Tip
-	}
-	return t
-}
-
-// ParseBlockQuoteType parses the first line of a blockquote and returns its type
-func ParseBlockQuoteType(node ast.Node, source []byte) BlockQuoteType {
-	var t = None
-	var legacyClassifier = LegacyBlockQuoteClassifier()
-	var ghAlertsClassifier = GHAlertsBlockQuoteClassifier()
-
-	countParagraphs := 0
-	_ = ast.Walk(node, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
-
-		if node.Kind() == ast.KindParagraph && entering {
-			countParagraphs += 1
-		}
-		// Type of block quote should be defined on the first blockquote line
-		if countParagraphs < 2 && entering {
-			if node.Kind() == ast.KindText {
-				n := node.(*ast.Text)
-				t = legacyClassifier.ClassifyingBlockQuote(string(n.Value(source)))
-				// If the node is a text node but classification returned none do not give up!
-				// Find the next two sibling nodes midNode and rightNode,
-				// 1. If both are also a text node
-				// 2. and the original node (node) text value is '['
-				// 3. and the rightNode text value is ']'
-				// It means with high degree of confidence that the original md doc contains a Github alert type of blockquote
-				// Classifying the next text type node (midNode) will confirm that.
-				if t == None {
-					midNode := node.NextSibling()
-
-					if midNode != nil && midNode.Kind() == ast.KindText {
-						rightNode := midNode.NextSibling()
-						midTextNode := midNode.(*ast.Text)
-						if rightNode != nil && rightNode.Kind() == ast.KindText {
-							rightTextNode := rightNode.(*ast.Text)
-							if string(n.Value(source)) == "[" && string(rightTextNode.Value(source)) == "]" {
-								t = ghAlertsClassifier.ClassifyingBlockQuote(string(midTextNode.Value(source)))
-							}
-						}
-					}
-				}
-				countParagraphs += 1
+// classificationKeywords are the byte sequences one of which the first block
+// of a blockquote has to contain to be classified by classifyBlockQuote
+var classificationKeywords = [][]byte{
+	[]byte("[!"),
+	[]byte("info"),
+	[]byte("note"),
+	[]byte("warn"),
+	[]byte("tip"),
+}
+
+// mayClassify reports whether the lines of the first block of node contain
+// one of the classificationKeywords. Most blockquotes are plain quotes, which
+// this rejects without walking them.
+func mayClassify(node ast.Node, source []byte) bool {
+	first := node.FirstChild()
+	if first == nil || source == nil {
+		return first != nil
+	}
+	lines := first.Lines()
+	if lines.Len() == 0 {
+		return true
+	}
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		value := line.Value(source)
+		for _, keyword := range classificationKeywords {
+			if containsFold(value, keyword) {
+				return true
 			}
-			if node.Kind() == ast.KindHTMLBlock {
-
-				n := node.(*ast.HTMLBlock)
-				for i := 0; i < n.BaseBlock.Lines().Len(); i++ {
-					line := n.BaseBlock.Lines().At(i)
-					t = legacyClassifier.ClassifyingBlockQuote(string(line.Value(source)))
-					if t != None {
-						break
-					}
-				}
-				countParagraphs += 1
+		}
+	}
+	return false
+}
+
+// containsFold reports whether the lowercase ASCII word is within b, ignoring
+// case
+func containsFold(b, word []byte) bool {
+	for i := 0; i+len(word) <= len(b); i++ {
+		if (b[i] == word[0] || b[i]|0x20 == word[0]) && bytes.EqualFold(b[i:i+len(word)], word) {
+			return true
+		}
+	}
+	return false
+}
+
+// alertLine matches a GitHub alert marker at the start of a line, e.g.
+// "[!NOTE]", with the name in the first group and the severity of
+// "[!WARNING:2]", a single digit from 1 to 9 like for fenced admonitions, in
+// the second. Spaces within the brackets are tolerated, e.g. "[! NOTE ]", see
+// WithStrictAlertMarkers.
+var alertLine = regexp.MustCompile(`^[ \t]*\[[ \t]*![ \t]*([A-Za-z]+)(?:[ \t]*:[ \t]*([1-9]))?[ \t]*\]`)
+
+// classifyBlockQuote parses the first line of a blockquote and returns its
+// type. The line is read from source, so inline extensions splitting or
+// replacing its text don't affect the classification. The lines of HTML
+// blocks are classified only by the legacy keywords, where scope allows.
+func classifyBlockQuote(node ast.Node, source []byte, scope ClassificationScope) BlockQuoteType {
+	if source == nil || !mayClassify(node, source) {
+		return None
+	}
+
+	block := firstTextBlock(node)
+	if block == nil {
+		return None
+	}
+	lines := block.Lines()
+
+	if block.Kind() == ast.KindHTMLBlock {
+		for i := 0; i < lines.Len(); i++ {
+			line := lines.At(i)
+			if t := legacyClassifier.ClassifyingBlockQuote(string(line.Value(source))); t != None {
+				return t
+			}
+			if scope != ClassifyFirstBlock {
+				break
 			}
-		} else if countParagraphs > 1 && entering {
+		}
+		return None
+	}
+
+	line := lines.At(0)
+	value := withoutCodeSpans(line.Value(source))
+	if marker := alertLine.FindSubmatch(value); marker != nil {
+		if t := ghAlertsClassifier.ClassifyingBlockQuote("!" + string(marker[1])); t != None {
+			return t
+		}
+	}
+	switch scope {
+	case ClassifyFirstText:
+		value = firstText(block, source)
+	case ClassifyLeadingWord:
+		value = leadingWord(value)
+	}
+	return legacyClassifier.ClassifyingBlockQuote(string(value))
+}
+
+// alertType returns the type of the GitHub alert marker node starts with as
+// written, e.g. "BUG" for "> [!BUG]", nil without one
+func alertType(node ast.Node, source []byte) []byte {
+	block := firstTextBlock(node)
+	if block == nil || block.Kind() != ast.KindParagraph || source == nil {
+		return nil
+	}
+	line := block.Lines().At(0)
+	marker := alertLine.FindSubmatch(withoutCodeSpans(line.Value(source)))
+	if marker == nil {
+		return nil
+	}
+	return marker[1]
+}
+
+// withoutCodeSpans returns line with its code spans removed, so neither
+// "`[!NOTE]`" nor "`note`" classify a blockquote. Backticks that don't close
+// on the line are kept.
+func withoutCodeSpans(line []byte) []byte {
+	if bytes.IndexByte(line, '`') < 0 {
+		return line
+	}
+	var out []byte
+	for i := 0; i < len(line); {
+		if line[i] != '`' {
+			out = append(out, line[i])
+			i++
+			continue
+		}
+		run := i
+		for run < len(line) && line[run] == '`' {
+			run++
+		}
+		width := run - i
+		end := -1
+		for j := run; j < len(line); {
+			if line[j] != '`' {
+				j++
+				continue
+			}
+			k := j
+			for k < len(line) && line[k] == '`' {
+				k++
+			}
+			if k-j == width {
+				end = k
+				break
+			}
+			j = k
+		}
+		if end < 0 {
+			out = append(out, line[i:run]...)
+			i = run
+			continue
+		}
+		i = end
+	}
+	return out
+}
+
+// firstTextBlock returns the first descendant of node holding lines of text,
+// i.e. the block its first line belongs to. Code blocks are skipped. A nested
+// blockquote coming first is classified on its own, so there is none then.
+func firstTextBlock(node ast.Node) ast.Node {
+	var block ast.Node
+	_ = ast.Walk(node, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || child == node || child.Type() != ast.TypeBlock {
+			return ast.WalkContinue, nil
+		}
+		switch child.Kind() {
+		case ast.KindCodeBlock, ast.KindFencedCodeBlock:
+			return ast.WalkSkipChildren, nil
+		case ast.KindBlockquote:
+			return ast.WalkStop, nil
+		}
+		if child.Lines().Len() > 0 {
+			block = child
 			return ast.WalkStop, nil
 		}
 		return ast.WalkContinue, nil
 	})
-
-	return t
+	return block
 }
 
 // GenerateBlockQuoteLevel walks a given node and returns a map of blockquote levels
@@ -182,45 +581,296 @@ func GenerateBlockQuoteLevel(someNode ast.Node) BlockQuoteLevelMap {
 	return blockQuoteLevelMap
 }
 
-// renderBlockQuote will render a BlockQuote
-func (r *Renderer) renderAdmon(writer util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
-	//	Initialize BlockQuote level map
-	if r.LevelMap == nil {
-		r.LevelMap = GenerateBlockQuoteLevel(node)
-	}
+// RenderOpening writes the opening markup of an admonition, i.e. the wrapper,
+// the title and the start of the body, to any io.Writer. Together with
+// RenderClosing this lets you wrap content rendered elsewhere, e.g. straight
+// into an http.ResponseWriter or a gzip.Writer.
+func (r *Renderer) RenderOpening(w io.Writer, n *Admonition) error {
+	bw, flush := asBufWriter(w)
+	r.writeOpening(bw, nil, n)
+	return flush()
+}
 
-	quoteType := ParseBlockQuoteType(node, source)
-	quoteLevel := r.LevelMap.Level(node)
+// RenderClosing writes the closing markup of an admonition to any io.Writer.
+func (r *Renderer) RenderClosing(w io.Writer, n *Admonition) error {
+	bw, flush := asBufWriter(w)
+	r.writeClosing(bw, n)
+	return flush()
+}
 
-	if quoteLevel == 0 && entering && quoteType != None {
-		prefix := fmt.Sprintf("<ac:structured-macro ac:name=\"%s\"><ac:parameter ac:name=\"icon\">true</ac:parameter><ac:rich-text-body>\n", quoteType)
-		if _, err := writer.Write([]byte(prefix)); err != nil {
+// renderAdmonition will render an Admonition as a div wrapping a title and a
+// body div
+func (r *Renderer) renderAdmonition(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*Admonition)
+	r.trackRender(n, entering)
+	if r.isHidden(n) {
+		return ast.WalkSkipChildren, nil
+	}
+	if r.FailFast && entering {
+		if err := r.checkAdmonition(n, source); err != nil {
 			return ast.WalkStop, err
 		}
+	}
+	if n.IsRaw() {
+		return r.renderRaw(w, source, n, entering)
+	}
+	if r.Unwrap {
 		return ast.WalkContinue, nil
 	}
-	if quoteLevel == 0 && !entering && quoteType != None {
-		suffix := "</ac:rich-text-body></ac:structured-macro>\n"
-		if _, err := writer.Write([]byte(suffix)); err != nil {
-			return ast.WalkStop, err
+	if r.Target == TargetConfluence {
+		return r.renderConfluence(w, source, n, entering)
+	}
+	if r.Target == TargetWebComponent {
+		return r.renderWebComponent(w, source, n, entering)
+	}
+	if r.Responsive && r.markdown != nil {
+		return r.renderResponsive(w, source, n, entering)
+	}
+	if isDefinitions(n) && r.markdown != nil {
+		return r.renderDefinitions(w, source, n, entering)
+	}
+	if image, ok := figureImage(n); ok && r.Figures && r.markdown != nil {
+		return r.renderFigure(w, source, n, image, entering)
+	}
+	if _, ok := n.Collapsible(); ok {
+		return r.renderCollapsible(w, source, n, entering)
+	}
+	if r.WordLimit > 0 && r.markdown != nil && entering {
+		if cut := r.truncationPoint(n, source); cut != nil {
+			return r.renderTruncated(w, source, n, cut)
 		}
+	}
+	if entering {
+		r.writeOpening(w, source, n)
+	} else {
+		r.writeClosing(w, n)
+	}
+	return ast.WalkContinue, nil
+}
+
+// renderRaw writes the body of a raw admonition as is, without any wrapper.
+// Like raw HTML this requires Unsafe, and isn't done if Restricted.
+func (r *Renderer) renderRaw(w util.BufWriter, source []byte, n *Admonition, entering bool) (ast.WalkStatus, error) {
+	if !entering {
 		return ast.WalkContinue, nil
 	}
-	return r.renderAdmonition(writer, source, node, entering)
+	if !r.Unsafe || r.Restricted {
+		_, _ = w.WriteString("<!-- raw HTML omitted -->\n")
+		return ast.WalkSkipChildren, nil
+	}
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		_, _ = w.Write(line.Value(source))
+	}
+	return ast.WalkSkipChildren, nil
 }
 
-func (r *Renderer) renderAdmonition(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
-	n := node.(*Admonition)
-	if entering {
-		if n.Attributes() != nil {
-			_, _ = w.WriteString("<blockquote")
-			html.RenderAttributes(w, n, AdmonitionAttributeFilter)
-			_ = w.WriteByte('>')
-		} else {
-			_, _ = w.WriteString("<blockquote>\n")
+// idAttribute matches the id attributes of rendered HTML, quotes in text are
+// escaped
+var idAttribute = regexp.MustCompile(` id="([^"]*)"`)
+
+// idReference matches the attributes of rendered HTML naming an id, the ids
+// themselves, the for of labels and links within the page
+var idReference = regexp.MustCompile(` (id|for|href)="(#?)([^"]*)"`)
+
+// detailsCopy returns body with its ids suffixed with "-details", and the
+// labels and links referring to them, so they stay unique in a second copy
+func detailsCopy(body []byte) []byte {
+	ids := map[string]bool{}
+	for _, match := range idAttribute.FindAllSubmatch(body, -1) {
+		ids[string(match[1])] = true
+	}
+	return idReference.ReplaceAllFunc(body, func(attribute []byte) []byte {
+		match := idReference.FindSubmatch(attribute)
+		name, hash, id := string(match[1]), string(match[2]), string(match[3])
+		if !ids[id] || (name == "href") != (hash == "#") {
+			return attribute
 		}
-	} else {
-		_, _ = w.WriteString("</blockquote>\n")
+		return []byte(" " + name + `="` + hash + id + `-details"`)
+	})
+}
+
+// renderResponsive renders the always open and the <details> variant of n
+// one after the other, the ids of the <details> variant suffixed with
+// "-details" along with the labels and links referring to them. Only one of
+// them should be displayed, e.g.
+//
+//	.adm-details {display: none;}
+//	@media (max-width: 600px) {
+//	  .adm-open {display: none;}
+//	  .adm-details {display: block;}
+//	}
+func (r *Renderer) renderResponsive(w util.BufWriter, source []byte, n *Admonition, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		r.writeAttribution(w, n)
+		r.writeFooter(w, n)
+		_, _ = w.WriteString("</div>\n")
+		return ast.WalkContinue, nil
 	}
-	return ast.WalkContinue, nil
+
+	if r.CollapseScript {
+		r.writeCollapseScript(w, n)
+	}
+	r.writeWrapper(w, source, n)
+
+	_, _ = w.WriteString("  <div" + r.classAttribute("adm-open", string(n.AdmonitionClass)) + ">\n")
+	r.writeTitle(w, n, r.titleTag(n))
+	// the body is rendered once and copied, nested responsive admonitions
+	// would otherwise be rendered twice per level
+	var body bytes.Buffer
+	bw := bufio.NewWriter(&body)
+	if err := r.writeBody(bw, source, n); err != nil {
+		return ast.WalkStop, err
+	}
+	_ = bw.Flush()
+	_, _ = w.Write(body.Bytes())
+	_, _ = w.WriteString("  </div>\n")
+
+	_, _ = w.WriteString("  <details" + r.classAttribute("adm-details", string(n.AdmonitionClass)) + ">\n")
+	r.writeTitle(w, n, "summary")
+	// the ids of the body, e.g. of footnote references, must stay unique
+	_, _ = w.Write(detailsCopy(body.Bytes()))
+	_, _ = w.WriteString("  </details>\n")
+
+	return ast.WalkSkipChildren, nil
+}
+
+// writeBody renders the children of n into a body div
+func (r *Renderer) writeBody(w util.BufWriter, source []byte, n *Admonition) error {
+	if isDefinitions(n) {
+		return r.writeDefinitions(w, source, n)
+	}
+	r.writeBodyOpening(w, n)
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		if err := r.markdown.Render(w, source, child); err != nil {
+			return err
+		}
+	}
+	r.writeBodyClosing(w, n)
+	return nil
+}
+
+func (r *Renderer) writeOpening(w util.BufWriter, source []byte, n *Admonition) {
+	r.writeWrapper(w, source, n)
+	r.writeTitle(w, n, r.titleTag(n))
+	r.writeBodyOpening(w, n)
+}
+
+func (r *Renderer) writeClosing(w util.BufWriter, n *Admonition) {
+	r.writeBodyClosing(w, n)
+	r.writeAttribution(w, n)
+	r.writeFooter(w, n)
+	_, _ = w.WriteString("</div>\n")
+}
+
+func (r *Renderer) writeFooter(w util.BufWriter, n *Admonition) {
+	if !r.ApprovalFooter {
+		return
+	}
+	if approver, ok := n.ApprovedBy(); ok {
+		_, _ = w.WriteString("  <div" + r.classAttribute("adm-footer", string(n.AdmonitionClass)) + ">Approved by ")
+		_, _ = w.Write(util.EscapeHTML([]byte(approver)))
+		_, _ = w.WriteString("</div>\n")
+	}
+}
+
+func (r *Renderer) writeWrapper(w util.BufWriter, source []byte, n *Admonition) {
+	r.writeWrapperElement(w, source, n, "div")
+}
+
+// writeWrapperElement opens the wrapper of n as tag
+func (r *Renderer) writeWrapperElement(w util.BufWriter, source []byte, n *Admonition, tag string) {
+	_, _ = w.WriteString("<" + tag)
+	r.writeAttributes(w, n)
+	if open, _ := n.Collapsible(); open && tag == "details" {
+		_, _ = w.WriteString(" open")
+	}
+	if (r.Responsive || r.CollapseScript) && source != nil {
+		_, _ = w.WriteString(` data-adm-key="`)
+		_, _ = w.Write(util.EscapeHTML([]byte(r.admonitionKey(n, source))))
+		_, _ = w.WriteString(`"`)
+	}
+	if r.SourceMap && source != nil {
+		if entry, ok := sourceMapEntry(n, source); ok {
+			_, _ = fmt.Fprintf(w, " data-source-lines=\"%d-%d\"", entry.StartLine, entry.EndLine)
+		}
+	}
+	_, _ = w.WriteString(">\n")
+	if r.Metadata {
+		r.writeMetadata(w, n)
+	}
+	if r.ScreenReaderLabels {
+		r.writeScreenReaderLabel(w, n)
+	}
+}
+
+func (r *Renderer) writeTitle(w util.BufWriter, n *Admonition, tag string) {
+	_, _ = fmt.Fprintf(w, "  <%s%s>", tag, r.classAttribute("adm-title", string(n.AdmonitionClass)))
+	r.writeIcon(w, n)
+	r.writeTitleText(w, r.title(n))
+	_, _ = fmt.Fprintf(w, "</%s>\n", tag)
+}
+
+// title returns the title n is rendered with, see Config.TitleFunc and
+// Config.TitleFilter
+func (r *Renderer) title(n *Admonition) []byte {
+	title := n.Title
+	if r.TitleFunc != nil {
+		title = []byte(r.TitleFunc(n))
+	}
+	if r.TitleFilter != nil {
+		title = []byte(r.TitleFilter(string(title)))
+	}
+	return title
+}
+
+// titleTag returns the name of the element the title of n is rendered as
+func (r *Renderer) titleTag(n *Admonition) string {
+	switch r.TitleElement {
+	case TitleParagraph:
+		return "p"
+	case TitleSpan:
+		return "span"
+	case TitleHeading:
+		level := r.TitleLevel
+		if level == 0 {
+			level = autoTitleLevel(n)
+		}
+		if level < 1 {
+			level = 1
+		} else if level > 6 {
+			level = 6
+		}
+		return fmt.Sprintf("h%d", level)
+	}
+	return "div"
+}
+
+// autoTitleLevel returns the level one below the closest heading preceding n,
+// plus one for every admonition n is nested in
+func autoTitleLevel(n *Admonition) int {
+	root := ast.Node(n)
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+
+	headingLevel := 0
+	_ = ast.Walk(root, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if node == n {
+			return ast.WalkStop, nil
+		}
+		if heading, ok := node.(*ast.Heading); ok && entering {
+			headingLevel = heading.Level
+		}
+		return ast.WalkContinue, nil
+	})
+
+	level := headingLevel + 1
+	for parent := n.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Kind() == KindAdmonition {
+			level++
+		}
+	}
+	return level
 }
@@ -0,0 +1,202 @@
+package admonitions
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// A FileReport counts the admonitions of a single Markdown file per type
+type FileReport struct {
+	Path   string         `json:"path"`
+	Counts map[string]int `json:"counts"`
+}
+
+// A TreeReport counts the admonitions of all Markdown files in a directory
+// tree, per file and in total
+type TreeReport struct {
+	Files  []FileReport   `json:"files"`
+	Totals map[string]int `json:"totals"`
+}
+
+// markdownExtensions are the file extensions ReportTree parses
+var markdownExtensions = map[string]bool{".md": true, ".markdown": true}
+
+// ReportTree walks fsys, parses every Markdown file with md and counts its
+// admonitions. Both admonition blocks (by class) and classified blockquotes
+// (by BlockQuoteType) are counted, so md should use the Extender. The counts
+// are those of NewReport.
+func ReportTree(fsys fs.FS, md goldmark.Markdown) (*TreeReport, error) {
+	report := &TreeReport{Totals: map[string]int{}}
+
+	err := walkMarkdownFiles(fsys, md, func(p string, source []byte, doc ast.Node, pc parser.Context) error {
+		counts := NewReport(p, doc, source, BlockQuoteTypes(pc)).Counts
+
+		report.Files = append(report.Files, FileReport{Path: p, Counts: counts})
+		for t, count := range counts {
+			report.Totals[t] += count
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// walkMarkdownFiles parses every Markdown file of fsys with md and calls fn
+// with the result
+func walkMarkdownFiles(fsys fs.FS, md goldmark.Markdown, fn func(p string, source []byte, doc ast.Node, pc parser.Context) error) error {
+	return fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !markdownExtensions[path.Ext(p)] {
+			return nil
+		}
+
+		source, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+
+		pc := parser.NewContext()
+		doc := md.Parser().Parse(text.NewReader(source), parser.WithContext(pc))
+		return fn(p, source, doc, pc)
+	})
+}
+
+// reportedType returns the type node is reported as, the class of
+// admonitions and the BlockQuoteType of classified blockquotes, or "" if it
+// isn't reported
+func reportedType(node ast.Node, types BlockQuoteTypeMap) string {
+	if n, ok := node.(*Admonition); ok {
+		return string(n.AdmonitionClass)
+	}
+	if node.Kind() == ast.KindBlockquote {
+		if t := types.Type(node); t != None {
+			return t.String()
+		}
+	}
+	return ""
+}
+
+// WriteJSON writes the report as indented JSON
+func (r *TreeReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes the report as CSV with the columns path, type and count.
+// Rows are sorted by type within each file.
+func (r *TreeReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"path", "type", "count"}); err != nil {
+		return err
+	}
+	for _, file := range r.Files {
+		for _, t := range sortedKeys(file.Counts) {
+			if err := writer.Write([]string{file.Path, t, strconv.Itoa(file.Counts[t])}); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func sortedKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// A Report describes the admonitions of one document or, merged with
+// MergeReports, of many, e.g. to serve them to a docs dashboard as JSON. The
+// JSON field names are stable.
+type Report struct {
+	Files       []string       `json:"files"`
+	Counts      map[string]int `json:"counts"` // admonitions per type
+	Words       int            `json:"words"`  // words in the bodies of all admonitions
+	Admonitions []ReportEntry  `json:"admonitions"`
+}
+
+// A ReportEntry describes a single admonition of a Report
+type ReportEntry struct {
+	File      string `json:"file"`
+	Type      string `json:"type"`
+	Title     string `json:"title,omitempty"`
+	StartLine int    `json:"startLine"` // the line of the opening tag, 0 if not from source
+	EndLine   int    `json:"endLine"`
+	Words     int    `json:"words"` // words in the body, nested admonitions included
+}
+
+// NewReport returns the report of the admonitions of doc, parsed from the file
+// file. Blockquotes classified in types, see BlockQuoteTypes, are reported
+// like admonitions even if they weren't converted. Words nested admonitions
+// share with their parents are only counted once for the whole report.
+func NewReport(file string, doc ast.Node, source []byte, types BlockQuoteTypeMap) *Report {
+	report := &Report{Files: []string{file}, Counts: map[string]int{}, Admonitions: []ReportEntry{}}
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		t := reportedType(node, types)
+		if t == "" || !entering {
+			return ast.WalkContinue, nil
+		}
+
+		entry := ReportEntry{
+			File:  file,
+			Type:  t,
+			Words: len(strings.Fields(string(plainText(node, source)))),
+		}
+		if n, ok := node.(*Admonition); ok {
+			entry.Title = string(n.Title)
+			if lines, ok := sourceMapEntry(n, source); ok {
+				entry.StartLine, entry.EndLine = lines.StartLine, lines.EndLine
+			}
+		} else if start, stop, ok := sourceRange(node); ok {
+			entry.StartLine, entry.EndLine = lineAt(source, start), lineAt(source, stop-1)
+		}
+
+		report.Counts[entry.Type]++
+		nested := false
+		for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+			nested = nested || reportedType(parent, types) != ""
+		}
+		if !nested {
+			report.Words += entry.Words
+		}
+		report.Admonitions = append(report.Admonitions, entry)
+		return ast.WalkContinue, nil
+	})
+	return report
+}
+
+// MergeReports merges reports, e.g. of all files of a site, into one. The
+// files and admonitions keep the order of reports.
+func MergeReports(reports ...*Report) *Report {
+	merged := &Report{Files: []string{}, Counts: map[string]int{}, Admonitions: []ReportEntry{}}
+	for _, report := range reports {
+		merged.Files = append(merged.Files, report.Files...)
+		for t, count := range report.Counts {
+			merged.Counts[t] += count
+		}
+		merged.Words += report.Words
+		merged.Admonitions = append(merged.Admonitions, report.Admonitions...)
+	}
+	return merged
+}
@@ -0,0 +1,136 @@
+package admonitions
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Restrictions harden the bodies of admonitions written by untrusted users,
+// regardless of how goldmark is configured, see WithRestrictions
+type Restrictions struct {
+	// Protocols are the URL schemes links and images may use,
+	// DefaultProtocols if nil. Relative URLs are always allowed, links to
+	// other schemes render as their text.
+	Protocols []string
+
+	// MaxImages is the number of images an admonition may contain, further
+	// ones render as their alt text. Negative values allow any number.
+	MaxImages int
+}
+
+// DefaultProtocols are the URL schemes Restrictions allow by default
+var DefaultProtocols = []string{"http", "https", "mailto"}
+
+// omittedHTML replaces the raw HTML of restricted admonitions, like goldmark
+// does without html.WithUnsafe
+var omittedHTML = []byte("<!-- raw HTML omitted -->")
+
+// restrictedTransformer applies Restrictions to the admonitions of documents
+type restrictedTransformer struct {
+	restrictions Restrictions
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *restrictedTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	protocols := t.restrictions.Protocols
+	if protocols == nil {
+		protocols = DefaultProtocols
+	}
+
+	// replacements are made once the walk is done
+	type replacement struct {
+		old ast.Node
+		new []ast.Node
+	}
+	var replacements []replacement
+	images := map[*Admonition]int{}
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if n, ok := node.(*Admonition); ok {
+			withoutEventHandlers(n)
+		}
+		admonition := closestAdmonition(node)
+		if admonition == nil {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.RawHTML:
+			omitted := ast.NewString(omittedHTML)
+			omitted.SetCode(true)
+			replacements = append(replacements, replacement{n, []ast.Node{omitted}})
+		case *ast.HTMLBlock:
+			omitted := ast.NewString(append(omittedHTML[:len(omittedHTML):len(omittedHTML)], '\n'))
+			omitted.SetCode(true)
+			block := ast.NewTextBlock()
+			block.AppendChild(block, omitted)
+			replacements = append(replacements, replacement{n, []ast.Node{block}})
+		case *ast.Link:
+			if !allowedURL(n.Destination, protocols) {
+				var children []ast.Node
+				for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+					children = append(children, child)
+				}
+				replacements = append(replacements, replacement{n, children})
+			}
+		case *ast.AutoLink:
+			if n.AutoLinkType == ast.AutoLinkURL && !allowedURL(n.URL(source), protocols) {
+				replacements = append(replacements, replacement{n, []ast.Node{ast.NewString(n.Label(source))}})
+			}
+		case *ast.Image:
+			images[admonition]++
+			tooMany := t.restrictions.MaxImages >= 0 && images[admonition] > t.restrictions.MaxImages
+			if tooMany || !allowedURL(n.Destination, protocols) {
+				replacements = append(replacements, replacement{n, []ast.Node{ast.NewString(plainText(n, source))}})
+				return ast.WalkSkipChildren, nil
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, r := range replacements {
+		parent := r.old.Parent()
+		for _, n := range r.new {
+			parent.InsertBefore(parent, r.old, n)
+		}
+		parent.RemoveChild(parent, r.old)
+	}
+}
+
+// allowedURL reports whether destination is relative or uses one of
+// protocols
+func allowedURL(destination []byte, protocols []string) bool {
+	u, err := url.Parse(string(bytes.TrimSpace(destination)))
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return true
+	}
+	for _, protocol := range protocols {
+		if strings.EqualFold(u.Scheme, protocol) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutEventHandlers removes the attributes of n that are event handlers,
+// e.g. onclick, even if AdmonitionAttributeFilter allows them
+func withoutEventHandlers(n *Admonition) {
+	attributes := n.Attributes()
+	n.RemoveAttributes()
+	for _, attr := range attributes {
+		if !bytes.HasPrefix(bytes.ToLower(attr.Name), []byte("on")) {
+			n.SetAttribute(attr.Name, attr.Value)
+		}
+	}
+}
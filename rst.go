@@ -0,0 +1,224 @@
+package admonitions
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// rstOpener matches the first line of reStructuredText admonition
+// directives, the name in the first group and the argument, if any, in the
+// second
+var rstOpener = regexp.MustCompile(`^\.\. +([A-Za-z][\w-]*)::(?:[ \t]+(.*?))?[ \t]*\r?\n?$`)
+
+// rstOption matches the option fields following the first line, e.g.
+// ":class: wide"
+var rstOption = regexp.MustCompile(`^[ \t]*:([\w-]+):(?:[ \t]+(.*?))?[ \t]*\r?\n?$`)
+
+// rstTitles maps the admonition directives of reStructuredText and Sphinx to
+// their titles. The generic "admonition" takes its title as argument.
+var rstTitles = map[string]string{
+	"admonition": "",
+	"attention":  "Attention",
+	"caution":    "Caution",
+	"danger":     "Danger",
+	"error":      "Error",
+	"hint":       "Hint",
+	"important":  "Important",
+	"note":       "Note",
+	"seealso":    "See also",
+	"tip":        "Tip",
+	"warning":    "Warning",
+}
+
+// rstStatesKey maps the open reStructuredText admonitions to their rstState
+var rstStatesKey = parser.NewContextKey()
+
+// rstState is how far a reStructuredText admonition has been parsed
+type rstState struct {
+	offset  int  // the indentation of the opener
+	body    int  // the indentation of the body, 0 until its first line
+	options bool // whether option fields may still follow
+	generic bool // whether it's an "admonition" whose type is still open
+}
+
+// rstParser parses admonition directives as written for reStructuredText and
+// Sphinx, with a body indented deeper than the directive:
+//
+//	.. warning:: Don't run this in production.
+//
+//	   More details.
+//
+//	.. admonition:: A title of its own
+//	   :class: wide
+//
+//	   The body.
+//
+// The text following the specific directives starts their body, the generic
+// "admonition" takes it as title and is of class note unless its :class:
+// option names one. :name: sets the id. Other directives aren't parsed.
+type rstParser struct{}
+
+// Trigger implements parser.BlockParser.Trigger .
+func (b *rstParser) Trigger() []byte {
+	return []byte{'.'}
+}
+
+// Open implements parser.BlockParser.Open .
+func (b *rstParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	match := rstOpener.FindSubmatchIndex(line[pos:])
+	if match == nil {
+		return nil, parser.NoChildren
+	}
+	name := bytes.ToLower(line[pos+match[2] : pos+match[3]])
+	title, ok := rstTitles[string(name)]
+	if !ok {
+		return nil, parser.NoChildren
+	}
+	var argument []byte
+	if match[4] >= 0 {
+		argument = line[pos+match[4] : pos+match[5]]
+	}
+	if string(name) == "admonition" && len(argument) == 0 {
+		// the generic admonition requires a title
+		return nil, parser.NoChildren
+	}
+	offset, _ := util.IndentWidth(line, reader.LineOffset())
+
+	node := NewAdmonition()
+	node.AdmonitionClass = name
+	node.Title = []byte(title)
+	if string(name) == "admonition" {
+		node.AdmonitionClass = []byte("note")
+		node.Title = append([]byte{}, argument...)
+	}
+	node.SetAttributeString("class", admonitionClassAttribute(node.AdmonitionClass))
+	depth := 0
+	for p := parent; p != nil; p = p.Parent() {
+		if p.Kind() == KindAdmonition {
+			depth++
+		}
+	}
+	node.SetAttributeString("data-admonition", []byte(fmt.Sprint(depth)))
+	node.Opener = text.NewSegment(segment.Start, segment.Stop)
+	node.Body = text.NewSegment(segment.Stop, -1)
+	node.setMarker(reader.Source(), segment.Start+pos, segment.Start+pos+match[3]+2)
+
+	states, _ := pc.Get(rstStatesKey).(map[ast.Node]*rstState)
+	if states == nil {
+		states = map[ast.Node]*rstState{}
+		pc.Set(rstStatesKey, states)
+	}
+	states[node] = &rstState{offset: offset, options: true, generic: string(name) == "admonition"}
+
+	if len(argument) > 0 && string(name) != "admonition" {
+		// the argument is the first line of the body
+		states[node].options = false
+		node.Body.Start = segment.Start + pos + match[4]
+		reader.Advance(pos + match[4])
+		return node, parser.HasChildren
+	}
+	reader.Advance(segment.Len() - 1)
+	return node, parser.HasChildren
+}
+
+// Continue implements parser.BlockParser.Continue .
+func (b *rstParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	states, _ := pc.Get(rstStatesKey).(map[ast.Node]*rstState)
+	state := states[node]
+	if util.IsBlank(line) {
+		if state != nil {
+			state.options = false
+		}
+		reader.Advance(len(line) - 1)
+		return parser.Continue | parser.HasChildren
+	}
+	if state == nil {
+		return parser.Close
+	}
+
+	indent, _ := util.IndentWidth(line, reader.LineOffset())
+	if indent <= state.offset || (state.body > 0 && indent < state.body) {
+		return parser.Close
+	}
+	if state.options && node.ChildCount() == 0 {
+		if option := rstOption.FindSubmatch(line); option != nil {
+			applyRSTOption(node.(*Admonition), state, string(option[1]), option[2])
+			reader.Advance(len(line) - 1)
+			return parser.Continue | parser.NoChildren
+		}
+	}
+	state.options = false
+	if state.body == 0 {
+		state.body = indent
+	}
+	pos, padding := util.IndentPosition(line, reader.LineOffset(), state.body)
+	reader.AdvanceAndSetPadding(pos, padding)
+	return parser.Continue | parser.HasChildren
+}
+
+// applyRSTOption applies the option field ":name: value" to n. The first
+// class of generic admonitions is their type.
+func applyRSTOption(n *Admonition, state *rstState, name string, value []byte) {
+	switch name {
+	case "class":
+		classes := bytes.Fields(value)
+		if len(classes) == 0 {
+			return
+		}
+		if state.generic {
+			state.generic = false
+			n.AdmonitionClass = bytes.ToLower(classes[0])
+			classes = classes[1:]
+			n.SetAttributeString("class", admonitionClassAttribute(n.AdmonitionClass))
+		}
+		attribute, _ := n.AttributeString("class")
+		class := append([]byte{}, attribute.([]byte)...)
+		for _, extra := range classes {
+			class = append(append(class, ' '), extra...)
+		}
+		n.SetAttributeString("class", class)
+	case "name":
+		if len(value) > 0 {
+			n.SetAttributeString("id", append([]byte{}, value...))
+		}
+	}
+}
+
+// Close implements parser.BlockParser.Close .
+func (b *rstParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {
+	if states, ok := pc.Get(rstStatesKey).(map[ast.Node]*rstState); ok {
+		delete(states, node)
+	}
+
+	n := node.(*Admonition)
+	_, segment := reader.Position()
+	n.Body.Stop = segment.Start
+	if l := len(reader.Source()); n.Body.Stop > l {
+		n.Body.Stop = l
+	}
+	if n.Body.Stop < n.Body.Start {
+		n.Body.Stop = n.Body.Start
+	}
+}
+
+// CanInterruptParagraph implements parser.BlockParser.CanInterruptParagraph .
+func (b *rstParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser.CanAcceptIndentedLine .
+func (b *rstParser) CanAcceptIndentedLine() bool {
+	return false
+}
@@ -0,0 +1,112 @@
+package admonitions
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// ScopeHash returns a short token derived from name for WithClassScope, e.g.
+// to scope the classes of every micro-frontend by its name
+func ScopeHash(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(sum[:4])
+}
+
+// isScopedClass reports whether class is one of the classes this package
+// emits, which are scoped while classes added by authors aren't
+func isScopedClass(class string) bool {
+	return class == "admonition" || class == "admonition-inline" || strings.HasPrefix(class, "adm-")
+}
+
+// class returns the space separated classes of an admonition of type typ
+// with ClassStrategy or ClassScope applied, e.g. "adm-title-3f2a9c1d"
+func (r *Renderer) class(classes, typ string) string {
+	if r.ClassStrategy == nil && r.ClassScope == "" {
+		return classes
+	}
+	fields := strings.Fields(classes)
+	for i, class := range fields {
+		switch {
+		case !isScopedClass(class):
+		case r.ClassStrategy != nil:
+			fields[i] = r.ClassStrategy.Class(class, typ)
+		default:
+			fields[i] = class + "-" + r.ClassScope
+		}
+	}
+	return strings.Join(strings.Fields(strings.Join(fields, " ")), " ")
+}
+
+// classAttribute returns the class attribute of the classes of an admonition
+// of type typ as class does, with a leading space, or nothing if no class is
+// left, e.g. with UtilityClasses mapping them to ""
+func (r *Renderer) classAttribute(classes, typ string) string {
+	class := r.class(classes, typ)
+	if class == "" {
+		return ""
+	}
+	return ` class="` + string(util.EscapeHTML([]byte(class))) + `"`
+}
+
+// writeAttributes writes the attributes of n like html.RenderAttributes, but
+// with ClassStrategy or ClassScope applied to its classes
+func (r *Renderer) writeAttributes(w util.BufWriter, n ast.Node) {
+	depth := r.depthStyle(n)
+	for _, attr := range n.Attributes() {
+		if AdmonitionAttributeFilter != nil && !AdmonitionAttributeFilter.Contains(attr.Name) && !bytes.HasPrefix(attr.Name, []byte("data-")) {
+			continue
+		}
+		value := attributeBytes(attr.Value)
+		if bytes.Equal(attr.Name, []byte("class")) {
+			if a, ok := n.(*Admonition); ok && r.Expiry == ExpiryArchive && r.isExpired(a) {
+				value = append(append([]byte{}, value...), " adm-archived"...)
+			}
+			if a, ok := n.(*Admonition); ok && r.isTitleOnly(a) {
+				value = append(append([]byte{}, value...), " "+titleOnlyClass...)
+			}
+			typ := ""
+			if a, ok := n.(*Admonition); ok {
+				typ = string(a.AdmonitionClass)
+			}
+			if value = []byte(r.class(string(value), typ)); len(value) == 0 {
+				continue
+			}
+		}
+		if bytes.Equal(attr.Name, []byte("style")) && depth != nil {
+			if value = bytes.TrimRight(value, "; "); len(value) > 0 {
+				value = append(append([]byte{}, value...), "; "...)
+			}
+			value = append(value, depth...)
+			depth = nil
+		}
+		_, _ = w.WriteString(" ")
+		_, _ = w.Write(attr.Name)
+		_, _ = w.WriteString(`="`)
+		_, _ = w.Write(util.EscapeHTML(value))
+		_ = w.WriteByte('"')
+	}
+	if depth != nil {
+		_, _ = w.WriteString(` style="`)
+		_, _ = w.Write(depth)
+		_ = w.WriteByte('"')
+	}
+}
+
+// depthStyle returns the declaration of --adm-depth for n with DepthStyle,
+// nil for admonitions that aren't nested
+func (r *Renderer) depthStyle(n ast.Node) []byte {
+	if !r.DepthStyle || n.Kind() != KindAdmonition {
+		return nil
+	}
+	depth := admonitionDepth(n)
+	if depth == 0 {
+		return nil
+	}
+	return []byte(fmt.Sprintf("--adm-depth: %d", depth))
+}
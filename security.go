@@ -0,0 +1,78 @@
+package admonitions
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// An ExternalLink is a link inside an admonition pointing to a domain that
+// isn't allowed
+type ExternalLink struct {
+	Admonition *Admonition // the innermost admonition containing the link
+	URL        string
+	Host       string
+}
+
+// FindExternalLinks returns the links and images within the admonitions of
+// doc whose host isn't one of domains or a subdomain of them, e.g. to audit
+// that warnings don't send readers to untrusted pages. Relative links are
+// always allowed.
+func FindExternalLinks(doc ast.Node, source []byte, domains ...string) []ExternalLink {
+	var links []ExternalLink
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		var destination string
+		switch n := node.(type) {
+		case *ast.Link:
+			destination = string(n.Destination)
+		case *ast.Image:
+			destination = string(n.Destination)
+		case *ast.AutoLink:
+			if n.AutoLinkType != ast.AutoLinkURL {
+				return ast.WalkContinue, nil
+			}
+			destination = string(n.URL(source))
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		admonition := closestAdmonition(node)
+		if admonition == nil {
+			return ast.WalkContinue, nil
+		}
+		u, err := url.Parse(destination)
+		if err != nil || u.Hostname() == "" || isAllowedHost(u.Hostname(), domains) {
+			return ast.WalkContinue, nil
+		}
+		links = append(links, ExternalLink{Admonition: admonition, URL: destination, Host: u.Hostname()})
+		return ast.WalkContinue, nil
+	})
+	return links
+}
+
+// closestAdmonition returns the innermost admonition containing n, if any
+func closestAdmonition(n ast.Node) *Admonition {
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		if a, ok := p.(*Admonition); ok {
+			return a
+		}
+	}
+	return nil
+}
+
+// isAllowedHost reports whether host is one of domains or a subdomain of one
+func isAllowedHost(host string, domains []string) bool {
+	host = strings.ToLower(host)
+	for _, domain := range domains {
+		domain = strings.ToLower(domain)
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,356 @@
+package admonitions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// serialNode is an AST node as MarshalAdmonition writes it, with the source
+// its segments refer to resolved. Fields a kind of node doesn't have are left
+// out.
+type serialNode struct {
+	Kind       string      `json:"kind"`
+	Attributes [][2]string `json:"attrs,omitempty"` // names and values in order
+	Lines      []string    `json:"lines,omitempty"`
+	Blank      bool        `json:"blank,omitempty"` // blank lines precede the block
+	Text       string      `json:"text,omitempty"`
+	Soft       bool        `json:"soft,omitempty"`
+	Hard       bool        `json:"hard,omitempty"`
+	Raw        bool        `json:"raw,omitempty"`
+	Code       bool        `json:"code,omitempty"`
+	Level      int         `json:"level,omitempty"`
+	Dest       string      `json:"dest,omitempty"`
+	Title      string      `json:"title,omitempty"`
+	Info       *string     `json:"info,omitempty"`
+	Marker     string      `json:"marker,omitempty"`
+	Start      int         `json:"start,omitempty"`
+	Tight      bool        `json:"tight,omitempty"`
+	Offset     int         `json:"offset,omitempty"`
+	Type       int         `json:"type,omitempty"`
+	Protocol   string      `json:"protocol,omitempty"`
+	Align      []int       `json:"align,omitempty"`
+
+	// the fields of Admonition and the nodes of this package
+	Class     string            `json:"class,omitempty"`
+	AlertType string            `json:"alertType,omitempty"`
+	Open      bool              `json:"open,omitempty"`
+	Severity  int               `json:"severity,omitempty"`
+	Options   map[string]string `json:"options,omitempty"`
+	Opener    string            `json:"opener,omitempty"`
+	Body      string            `json:"body,omitempty"`
+	Closer    string            `json:"closer,omitempty"`
+	Keys      []string          `json:"keys,omitempty"`
+	Set       int               `json:"set,omitempty"`
+	Index     int               `json:"index,omitempty"`
+
+	Children []*serialNode `json:"children,omitempty"`
+}
+
+// MarshalAdmonition encodes n and everything it contains as compact JSON,
+// resolving the parts of source its nodes refer to, so an admonition can be
+// cached or handed to another process and rendered there after
+// UnmarshalAdmonition. Besides the nodes of this package, the nodes of
+// CommonMark and GFM can be encoded, others fail.
+func MarshalAdmonition(n *Admonition, source []byte) ([]byte, error) {
+	node, err := encodeNode(n, source)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(node)
+}
+
+// UnmarshalAdmonition decodes an admonition encoded by MarshalAdmonition. The
+// segments of its nodes refer to the returned source, which is what it has to
+// be rendered with.
+func UnmarshalAdmonition(data []byte) (*Admonition, []byte, error) {
+	var node serialNode
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, nil, fmt.Errorf("admonitions: %w", err)
+	}
+	d := &nodeDecoder{}
+	decoded, err := d.decode(&node)
+	if err != nil {
+		return nil, nil, err
+	}
+	n, ok := decoded.(*Admonition)
+	if !ok {
+		return nil, nil, fmt.Errorf("admonitions: an encoded %s isn't an admonition", node.Kind)
+	}
+	return n, d.source, nil
+}
+
+// encodeNode returns node and its children as serialNodes
+func encodeNode(node ast.Node, source []byte) (*serialNode, error) {
+	s := &serialNode{Kind: node.Kind().String()}
+	for _, attr := range node.Attributes() {
+		s.Attributes = append(s.Attributes, [2]string{string(attr.Name), string(attributeBytes(attr.Value))})
+	}
+	if node.Type() == ast.TypeBlock {
+		s.Blank = node.HasBlankPreviousLines()
+		lines := node.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			line := lines.At(i)
+			s.Lines = append(s.Lines, string(line.Value(source)))
+		}
+	}
+
+	switch n := node.(type) {
+	case *Admonition:
+		s.Class, s.Title, s.AlertType = string(n.AdmonitionClass), string(n.Title), string(n.AlertType)
+		s.Open, s.Severity, s.Options = n.DefaultOpen, n.Severity, n.Options
+		s.Marker = string(n.marker)
+		s.Opener, s.Body, s.Closer = string(n.Opener.Value(source)), string(n.Body.Value(source)), string(n.Closer.Value(source))
+	case *InlineAdmonition:
+		s.Class = string(n.AdmonitionClass)
+	case *Abbreviation:
+		s.Text, s.Title = string(n.Term), string(n.Expansion)
+	case *Keys:
+		for _, key := range n.Keys {
+			s.Keys = append(s.Keys, string(key))
+		}
+	case *ProblemNode:
+		s.Text = n.Err.Error()
+	case *Tab:
+		s.Text, s.Set, s.Index, s.Open = string(n.Label), n.Set, n.Index, n.Selected
+	case *ast.Text:
+		s.Text = string(n.Segment.Value(source))
+		s.Soft, s.Hard, s.Raw = n.SoftLineBreak(), n.HardLineBreak(), n.IsRaw()
+	case *ast.String:
+		s.Text, s.Raw, s.Code = string(n.Value), n.IsRaw(), n.IsCode()
+	case *ast.Emphasis:
+		s.Level = n.Level
+	case *ast.Link:
+		s.Dest, s.Title = string(n.Destination), string(n.Title)
+	case *ast.Image:
+		s.Dest, s.Title = string(n.Destination), string(n.Title)
+	case *ast.AutoLink:
+		s.Text, s.Type, s.Protocol = string(n.Label(source)), int(n.AutoLinkType), string(n.Protocol)
+	case *ast.RawHTML:
+		for i := 0; i < n.Segments.Len(); i++ {
+			segment := n.Segments.At(i)
+			s.Lines = append(s.Lines, string(segment.Value(source)))
+		}
+	case *ast.Heading:
+		s.Level = n.Level
+	case *ast.FencedCodeBlock:
+		if n.Info != nil {
+			info := string(n.Info.Segment.Value(source))
+			s.Info = &info
+		}
+	case *ast.List:
+		s.Marker, s.Start, s.Tight = string(n.Marker), n.Start, n.IsTight
+	case *ast.ListItem:
+		s.Offset = n.Offset
+	case *ast.HTMLBlock:
+		s.Type = int(n.HTMLBlockType)
+		if n.HasClosure() {
+			s.Text = string(n.ClosureLine.Value(source))
+			s.Code = true
+		}
+	case *east.Table:
+		s.Align = alignments(n.Alignments)
+	case *east.TableHeader:
+		s.Align = alignments(n.Alignments)
+	case *east.TableRow:
+		s.Align = alignments(n.Alignments)
+	case *east.TableCell:
+		s.Align = []int{int(n.Alignment)}
+	case *east.TaskCheckBox:
+		s.Open = n.IsChecked
+	case *ast.Paragraph, *ast.TextBlock, *ast.ThematicBreak, *ast.CodeBlock, *ast.Blockquote, *ast.CodeSpan, *east.Strikethrough:
+	default:
+		return nil, fmt.Errorf("admonitions: %s nodes can't be encoded", node.Kind())
+	}
+
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		c, err := encodeNode(child, source)
+		if err != nil {
+			return nil, err
+		}
+		s.Children = append(s.Children, c)
+	}
+	return s, nil
+}
+
+// alignments returns the alignments of table columns as ints
+func alignments(a []east.Alignment) []int {
+	ints := make([]int, len(a))
+	for i, alignment := range a {
+		ints[i] = int(alignment)
+	}
+	return ints
+}
+
+// nodeDecoder decodes serialNodes, collecting the source their segments
+// refer to
+type nodeDecoder struct {
+	source []byte
+}
+
+// segment appends s to the source and returns where it is
+func (d *nodeDecoder) segment(s string) text.Segment {
+	start := len(d.source)
+	d.source = append(d.source, s...)
+	return text.NewSegment(start, len(d.source))
+}
+
+// decode returns the node s encodes, with its children
+func (d *nodeDecoder) decode(s *serialNode) (ast.Node, error) {
+	var node ast.Node
+	switch s.Kind {
+	case KindAdmonition.String():
+		n := NewAdmonition()
+		n.AdmonitionClass, n.Title, n.AlertType = []byte(s.Class), []byte(s.Title), nullable(s.AlertType)
+		n.DefaultOpen, n.Severity, n.Options = s.Open, s.Severity, s.Options
+		n.Opener, n.Body, n.Closer = d.segment(s.Opener), d.segment(s.Body), d.segment(s.Closer)
+		if s.Marker != "" {
+			marker := d.segment(s.Marker)
+			n.setMarker(d.source, marker.Start, marker.Stop)
+		}
+		node = n
+	case KindInlineAdmonition.String():
+		node = NewInlineAdmonition([]byte(s.Class))
+	case KindAbbreviation.String():
+		node = NewAbbreviation([]byte(s.Text), []byte(s.Title))
+	case KindKeys.String():
+		keys := make([][]byte, len(s.Keys))
+		for i, key := range s.Keys {
+			keys[i] = []byte(key)
+		}
+		node = NewKeys(keys)
+	case KindProblem.String():
+		node = NewProblemNode(errors.New(s.Text))
+	case KindTab.String():
+		n := NewTab()
+		n.Label, n.Set, n.Index, n.Selected = []byte(s.Text), s.Set, s.Index, s.Open
+		node = n
+	case ast.KindText.String():
+		n := ast.NewTextSegment(d.segment(s.Text))
+		n.SetSoftLineBreak(s.Soft)
+		n.SetHardLineBreak(s.Hard)
+		n.SetRaw(s.Raw)
+		node = n
+	case ast.KindString.String():
+		n := ast.NewString([]byte(s.Text))
+		n.SetRaw(s.Raw)
+		n.SetCode(s.Code)
+		node = n
+	case ast.KindEmphasis.String():
+		node = ast.NewEmphasis(s.Level)
+	case ast.KindLink.String():
+		n := ast.NewLink()
+		n.Destination, n.Title = []byte(s.Dest), nullable(s.Title)
+		node = n
+	case ast.KindImage.String():
+		n := ast.NewImage(ast.NewLink())
+		n.Destination, n.Title = []byte(s.Dest), nullable(s.Title)
+		node = n
+	case ast.KindAutoLink.String():
+		n := ast.NewAutoLink(ast.AutoLinkType(s.Type), ast.NewTextSegment(d.segment(s.Text)))
+		n.Protocol = nullable(s.Protocol)
+		node = n
+	case ast.KindRawHTML.String():
+		n := ast.NewRawHTML()
+		for _, line := range s.Lines {
+			n.Segments.Append(d.segment(line))
+		}
+		node = n
+	case ast.KindCodeSpan.String():
+		node = ast.NewCodeSpan()
+	case ast.KindParagraph.String():
+		node = ast.NewParagraph()
+	case ast.KindTextBlock.String():
+		node = ast.NewTextBlock()
+	case ast.KindHeading.String():
+		node = ast.NewHeading(s.Level)
+	case ast.KindThematicBreak.String():
+		node = ast.NewThematicBreak()
+	case ast.KindCodeBlock.String():
+		node = ast.NewCodeBlock()
+	case ast.KindFencedCodeBlock.String():
+		var info *ast.Text
+		if s.Info != nil {
+			info = ast.NewTextSegment(d.segment(*s.Info))
+		}
+		node = ast.NewFencedCodeBlock(info)
+	case ast.KindBlockquote.String():
+		node = ast.NewBlockquote()
+	case ast.KindList.String():
+		if len(s.Marker) != 1 {
+			return nil, fmt.Errorf("admonitions: an encoded list has the marker %q", s.Marker)
+		}
+		n := ast.NewList(s.Marker[0])
+		n.Start, n.IsTight = s.Start, s.Tight
+		node = n
+	case ast.KindListItem.String():
+		node = ast.NewListItem(s.Offset)
+	case ast.KindHTMLBlock.String():
+		n := ast.NewHTMLBlock(ast.HTMLBlockType(s.Type))
+		if s.Code {
+			n.ClosureLine = d.segment(s.Text)
+		}
+		node = n
+	case east.KindTable.String():
+		n := east.NewTable()
+		n.Alignments = fromInts(s.Align)
+		node = n
+	case east.KindTableHeader.String():
+		node = &east.TableHeader{Alignments: fromInts(s.Align)}
+	case east.KindTableRow.String():
+		node = east.NewTableRow(fromInts(s.Align))
+	case east.KindTableCell.String():
+		n := east.NewTableCell()
+		if len(s.Align) == 1 {
+			n.Alignment = east.Alignment(s.Align[0])
+		}
+		node = n
+	case east.KindStrikethrough.String():
+		node = east.NewStrikethrough()
+	case east.KindTaskCheckBox.String():
+		node = east.NewTaskCheckBox(s.Open)
+	default:
+		return nil, fmt.Errorf("admonitions: %s nodes can't be decoded", s.Kind)
+	}
+
+	for _, attr := range s.Attributes {
+		node.SetAttributeString(attr[0], []byte(attr[1]))
+	}
+	if node.Type() == ast.TypeBlock {
+		node.SetBlankPreviousLines(s.Blank)
+		lines := text.NewSegments()
+		for _, line := range s.Lines {
+			lines.Append(d.segment(line))
+		}
+		node.SetLines(lines)
+	}
+	for _, c := range s.Children {
+		child, err := d.decode(c)
+		if err != nil {
+			return nil, err
+		}
+		node.AppendChild(node, child)
+	}
+	return node, nil
+}
+
+// nullable returns s as bytes, nil if it is empty
+func nullable(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	return []byte(s)
+}
+
+// fromInts returns the alignments of table columns encoded as ints
+func fromInts(ints []int) []east.Alignment {
+	a := make([]east.Alignment, len(ints))
+	for i, alignment := range ints {
+		a[i] = east.Alignment(alignment)
+	}
+	return a
+}
@@ -0,0 +1,49 @@
+package admonitions
+
+import (
+	"strconv"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// severityAttribute is set to the severity of admonitions given one, e.g.
+// "2" for "!!!2 warning"
+const severityAttribute = "data-severity"
+
+// severityPrefix returns the severity line starts with and the length of it
+// and the spaces following it, e.g. 2 and 2 for "2 warning". Severities are a
+// single digit from 1 to 9, longer numbers and numbers without a class
+// following them are the class, e.g. "2024 Release notes".
+func severityPrefix(line []byte) (severity, length int) {
+	if len(line) < 2 || !isSeverity(line[:1]) {
+		return 0, 0
+	}
+	j := 1
+	for ; j < len(line) && (line[j] == ' ' || line[j] == '\t'); j++ {
+	}
+	if j == 1 || j == len(line) {
+		return 0, 0
+	}
+	return int(line[0] - '0'), j
+}
+
+// isSeverity reports whether b is a severity, a single digit from 1 to 9
+func isSeverity(b []byte) bool {
+	return len(b) == 1 && b[0] >= '1' && b[0] <= '9'
+}
+
+// alertSeverity returns the severity of the GitHub alert marker quote starts
+// with, e.g. 2 for "> [!WARNING:2]", 0 without one
+func alertSeverity(quote ast.Node, source []byte) int {
+	block := firstTextBlock(quote)
+	if block == nil || block.Kind() != ast.KindParagraph || source == nil {
+		return 0
+	}
+	line := block.Lines().At(0)
+	marker := alertLine.FindSubmatch(withoutCodeSpans(line.Value(source)))
+	if marker == nil || marker[2] == nil {
+		return 0
+	}
+	severity, _ := strconv.Atoi(string(marker[2]))
+	return severity
+}
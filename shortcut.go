@@ -0,0 +1,119 @@
+package admonitions
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// shortcutClass is the class of admonitions listing keyboard shortcuts. Code
+// spans in their body are rendered as keys, e.g. `Ctrl+Shift+P`.
+var shortcutClass = []byte("shortcut")
+
+// A Keys struct represents a key combination in a shortcut admonition.
+type Keys struct {
+	ast.BaseInline
+	Keys [][]byte // the keys to press together, e.g. "Ctrl" and "P"
+}
+
+// Dump implements Node.Dump .
+func (n *Keys) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Keys": string(bytes.Join(n.Keys, []byte("+"))),
+	}
+	ast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindKeys is a NodeKind of the Keys node.
+var KindKeys = ast.NewNodeKind("Keys")
+
+// Kind implements Node.Kind.
+func (n *Keys) Kind() ast.NodeKind {
+	return KindKeys
+}
+
+// NewKeys returns a new Keys node.
+func NewKeys(keys [][]byte) *Keys {
+	return &Keys{Keys: keys}
+}
+
+// splitKeys splits a key combination at "+". A "+" that doesn't follow a key
+// is a key itself, e.g. "Ctrl++".
+func splitKeys(combination []byte) [][]byte {
+	var keys [][]byte
+	var key []byte
+	for _, c := range combination {
+		if c == '+' && len(bytes.TrimSpace(key)) > 0 {
+			keys = append(keys, bytes.TrimSpace(key))
+			key = nil
+			continue
+		}
+		key = append(key, c)
+	}
+	if key = bytes.TrimSpace(key); len(key) > 0 {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// shortcutTransformer replaces the code spans of shortcut admonitions with
+// Keys nodes
+type shortcutTransformer struct {
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *shortcutTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+
+	var spans []*ast.CodeSpan
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*ast.CodeSpan); ok && entering && insideShortcut(n) {
+			spans = append(spans, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range spans {
+		var combination []byte
+		for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+			if t, ok := child.(*ast.Text); ok {
+				combination = append(combination, t.Segment.Value(source)...)
+			}
+		}
+		if keys := splitKeys(combination); len(keys) > 0 {
+			n.Parent().ReplaceChild(n.Parent(), n, NewKeys(keys))
+		}
+	}
+}
+
+// insideShortcut reports whether n is part of the body of a shortcut
+// admonition
+func insideShortcut(n ast.Node) bool {
+	for p := n.Parent(); p != nil; p = p.Parent() {
+		if a, ok := p.(*Admonition); ok {
+			return bytes.Equal(a.AdmonitionClass, shortcutClass)
+		}
+	}
+	return false
+}
+
+// renderKeys renders a key combination as nested <kbd> elements
+func (r *Renderer) renderKeys(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	_, _ = w.WriteString(`<kbd` + r.classAttribute("adm-keys", "") + `>`)
+	for i, key := range node.(*Keys).Keys {
+		if i > 0 {
+			_, _ = w.WriteString("+")
+		}
+		_, _ = w.WriteString("<kbd>")
+		_, _ = w.Write(util.EscapeHTML(key))
+		_, _ = w.WriteString("</kbd>")
+	}
+	_, _ = w.WriteString("</kbd>")
+	return ast.WalkSkipChildren, nil
+}
@@ -0,0 +1,71 @@
+package admonitions
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// A SourceMapEntry maps a rendered admonition to the lines of its source
+type SourceMapEntry struct {
+	Index     int    `json:"index"` // the position of the admonition in the document
+	Class     string `json:"class"`
+	StartLine int    `json:"startLine"` // the line of the opening tag, starting at 1
+	EndLine   int    `json:"endLine"`   // the last line of the admonition
+}
+
+// SourceMap returns the source lines of all admonitions of doc in document
+// order, matching the data-source-lines attributes rendered with
+// WithSourceMap. Admonitions that don't originate from source are skipped.
+func SourceMap(doc ast.Node, source []byte) []SourceMapEntry {
+	var entries []SourceMapEntry
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		n, ok := node.(*Admonition)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		if entry, ok := sourceMapEntry(n, source); ok {
+			entry.Index = len(entries)
+			entries = append(entries, entry)
+		}
+		return ast.WalkContinue, nil
+	})
+	return entries
+}
+
+// WriteSourceMap writes the SourceMap of doc as JSON
+func WriteSourceMap(w io.Writer, doc ast.Node, source []byte) error {
+	entries := SourceMap(doc, source)
+	if entries == nil {
+		entries = []SourceMapEntry{}
+	}
+	return json.NewEncoder(w).Encode(entries)
+}
+
+// sourceMapEntry returns the lines of n without an index
+func sourceMapEntry(n *Admonition, source []byte) (SourceMapEntry, bool) {
+	if n.Opener.Len() == 0 || n.Opener.Stop > len(source) {
+		return SourceMapEntry{}, false
+	}
+
+	entry := SourceMapEntry{
+		Class:     string(n.AdmonitionClass),
+		StartLine: lineAt(source, n.Opener.Start),
+	}
+	switch {
+	case n.Closer.Len() > 0:
+		entry.EndLine = lineAt(source, n.Closer.Start)
+	case n.Body.Len() > 0:
+		entry.EndLine = lineAt(source, n.Body.Stop-1)
+	default:
+		entry.EndLine = entry.StartLine
+	}
+	return entry, true
+}
+
+// lineAt returns the line of source offset is in, starting at 1
+func lineAt(source []byte, offset int) int {
+	return bytes.Count(source[:offset], []byte{'\n'}) + 1
+}
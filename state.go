@@ -0,0 +1,100 @@
+package admonitions
+
+import (
+	"sync"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// renderState is what rendering a document remembers from one admonition to
+// the next. It belongs to the document rather than the shared Renderer, so
+// documents can be rendered concurrently and repeatedly; rendering the same
+// document concurrently isn't supported.
+type renderState struct {
+	sprite bool                   // whether the icon sprite has been written
+	script bool                   // whether the collapse script has been written
+	keys   map[*Admonition]string // the data-adm-keys of the admonitions, computed once
+}
+
+// renderStateAttribute holds the renderState of a document on its root
+var renderStateAttribute = []byte("adm-render-state")
+
+// detachedStates are the renderStates of admonitions rendered without a
+// document, e.g. decoded by UnmarshalAdmonition, by their root
+type detachedStates struct {
+	mu     sync.Mutex
+	states map[ast.Node]*renderState
+}
+
+// documentRoot returns the root of the tree n is part of
+func documentRoot(n ast.Node) ast.Node {
+	for n.Parent() != nil {
+		n = n.Parent()
+	}
+	return n
+}
+
+// state returns the renderState of the document n is rendered in
+func (r *Renderer) state(n ast.Node) *renderState {
+	doc := documentRoot(n)
+	if doc.Kind() != ast.KindDocument {
+		r.detached.mu.Lock()
+		defer r.detached.mu.Unlock()
+		s, ok := r.detached.states[doc]
+		if !ok {
+			s = &renderState{}
+			if r.detached.states == nil {
+				r.detached.states = map[ast.Node]*renderState{}
+			}
+			r.detached.states[doc] = s
+		}
+		return s
+	}
+	if value, ok := doc.Attribute(renderStateAttribute); ok {
+		if s, ok := value.(*renderState); ok {
+			return s
+		}
+	}
+	s := &renderState{}
+	doc.SetAttribute(renderStateAttribute, s)
+	return s
+}
+
+// trackRender starts a new renderState when entering the first admonition of
+// a document, which any render of it begins with, and drops the state of
+// detached admonitions when leaving their root
+func (r *Renderer) trackRender(n *Admonition, entering bool) {
+	doc := documentRoot(n)
+	if !entering {
+		if doc == ast.Node(n) {
+			r.detached.mu.Lock()
+			delete(r.detached.states, doc)
+			r.detached.mu.Unlock()
+		}
+		return
+	}
+	if firstAdmonition(doc) != n {
+		return
+	}
+	if doc.Kind() == ast.KindDocument {
+		doc.SetAttribute(renderStateAttribute, &renderState{})
+		return
+	}
+	r.detached.mu.Lock()
+	delete(r.detached.states, doc)
+	r.detached.mu.Unlock()
+}
+
+// firstAdmonition returns the first admonition of the tree of root in
+// document order, nil if there is none
+func firstAdmonition(root ast.Node) *Admonition {
+	var first *Admonition
+	_ = ast.Walk(root, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*Admonition); ok && entering {
+			first = n
+			return ast.WalkStop, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return first
+}
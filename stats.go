@@ -0,0 +1,154 @@
+package admonitions
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+// A Timing is how long rendering an admonition took, the admonitions nested
+// in it included
+type Timing struct {
+	Class    string
+	Title    string
+	Line     int // the line of its opener, 0 if unknown
+	Depth    int // the number of admonitions it is nested in
+	Duration time.Duration
+}
+
+// Stats collects the Timings of the admonitions rendered, see WithStats. It
+// may be shared by renderers running concurrently.
+type Stats struct {
+	// Now returns the current time to measure with, time.Now if nil, e.g.
+	// a fake clock in tests
+	Now func() time.Time
+
+	mu      sync.Mutex
+	timings []Timing
+	starts  map[ast.Node]time.Time // the admonitions being rendered
+}
+
+// now returns the current time by Now
+func (s *Stats) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// start records that rendering n starts now
+func (s *Stats) start(n ast.Node) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.starts == nil {
+		s.starts = map[ast.Node]time.Time{}
+	}
+	s.starts[n] = now
+}
+
+// stop returns when rendering n started and forgets it, false if it wasn't
+// recorded
+func (s *Stats) stop(n ast.Node) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	start, ok := s.starts[n]
+	delete(s.starts, n)
+	return start, ok
+}
+
+// add records timing
+func (s *Stats) add(timing Timing) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timings = append(s.timings, timing)
+}
+
+// Timings returns the timings collected so far, in the order the admonitions
+// were done rendering, inner ones before the ones they are nested in
+func (s *Stats) Timings() []Timing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Timing(nil), s.timings...)
+}
+
+// Total returns how long rendering all admonitions took, counting nested ones
+// once
+func (s *Stats) Total() time.Duration {
+	var total time.Duration
+	for _, timing := range s.Timings() {
+		if timing.Depth == 0 {
+			total += timing.Duration
+		}
+	}
+	return total
+}
+
+// Slowest returns the n admonitions rendering took longest for, slowest
+// first
+func (s *Stats) Slowest(n int) []Timing {
+	timings := s.Timings()
+	sort.SliceStable(timings, func(i, j int) bool {
+		return timings[i].Duration > timings[j].Duration
+	})
+	if n < len(timings) {
+		timings = timings[:n]
+	}
+	return timings
+}
+
+// Slow returns the admonitions rendering took threshold or longer for, in
+// the order of Timings
+func (s *Stats) Slow(threshold time.Duration) []Timing {
+	var slow []Timing
+	for _, timing := range s.Timings() {
+		if timing.Duration >= threshold {
+			slow = append(slow, timing)
+		}
+	}
+	return slow
+}
+
+// Reset discards the timings collected so far, e.g. between builds
+func (s *Stats) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timings = nil
+}
+
+// timed wraps the function rendering admonitions, adding a Timing to Stats
+// for each of them
+func (r *Renderer) timed(render renderer.NodeRendererFunc) renderer.NodeRendererFunc {
+	return func(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering {
+			r.Stats.start(node)
+			return render(w, source, node, entering)
+		}
+
+		status, err := render(w, source, node, entering)
+		start, ok := r.Stats.stop(node)
+		if !ok {
+			return status, err
+		}
+		n := node.(*Admonition)
+		timing := Timing{
+			Class:    string(n.AdmonitionClass),
+			Title:    string(n.Title),
+			Duration: r.Stats.now().Sub(start),
+		}
+		if n.Opener.Len() > 0 && n.Opener.Stop <= len(source) {
+			timing.Line = lineAt(source, n.Opener.Start)
+		}
+		for p := n.Parent(); p != nil; p = p.Parent() {
+			if p.Kind() == KindAdmonition {
+				timing.Depth++
+			}
+		}
+		r.Stats.add(timing)
+		return status, err
+	}
+}
@@ -0,0 +1,45 @@
+package admonitions
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// DefaultStepPattern matches the names of stepped alert markers like
+// "[!STEP 3]", with the number in the first group
+var DefaultStepPattern = regexp.MustCompile(`(?i)^step[ \t]+(\d+)$`)
+
+// stepAttribute records the number of a step admonition, it renders as is
+var stepAttribute = []byte("data-step")
+
+// stepNumber returns the number of the step marker quote starts with, if its
+// name matches pattern
+func stepNumber(quote ast.Node, pattern *regexp.Regexp, source []byte) (int, bool) {
+	paragraph, ok := quote.FirstChild().(*ast.Paragraph)
+	if !ok || pattern == nil {
+		return 0, false
+	}
+	_, name, ok := titledAlertMarker(paragraph.FirstChild(), source)
+	if !ok {
+		return 0, false
+	}
+	match := pattern.FindStringSubmatch(name)
+	if len(match) < 2 {
+		return 0, false
+	}
+	number, err := strconv.Atoi(match[1])
+	return number, err == nil
+}
+
+// Step returns the number of a step admonition made from a "[!STEP 3]"
+// blockquote and whether n is one, see WithStepAlerts
+func (n *Admonition) Step() (int, bool) {
+	value, ok := n.Attribute(stepAttribute)
+	if !ok {
+		return 0, false
+	}
+	number, err := strconv.Atoi(string(attributeBytes(value)))
+	return number, err == nil
+}
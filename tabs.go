@@ -0,0 +1,190 @@
+package admonitions
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// tabOpener matches the first line of MkDocs content tabs, the flags in the
+// first group and the label in the second
+var tabOpener = regexp.MustCompile(`^===([!+]{0,2}) +"(.*)"[ \t]*\r?\n?$`)
+
+// tabSetsKey counts the tab sets of a document
+var tabSetsKey = parser.NewContextKey()
+
+// A Tab is one of the content tabs of an admonition. Consecutive tabs form a
+// set, only one of which is shown at a time.
+type Tab struct {
+	ast.BaseBlock
+	Label    []byte // the text of the tab's label
+	Set      int    // the number of the set in the document, from 1
+	Index    int    // the number of the tab in its set, from 1
+	Selected bool   // whether the tab is shown first, "===+"
+
+	offset int // the indentation of the opener
+}
+
+// Dump implements Node.Dump .
+func (n *Tab) Dump(source []byte, level int) {
+	m := map[string]string{
+		"Label": string(n.Label),
+		"Set":   fmt.Sprint(n.Set),
+		"Index": fmt.Sprint(n.Index),
+	}
+	ast.DumpHelper(n, source, level, m, nil)
+}
+
+// KindTab is a NodeKind of the Tab node.
+var KindTab = ast.NewNodeKind("Tab")
+
+// Kind implements Node.Kind.
+func (n *Tab) Kind() ast.NodeKind {
+	return KindTab
+}
+
+// NewTab returns a new Tab node.
+func NewTab() *Tab {
+	return &Tab{}
+}
+
+// tabParser parses the content tabs of pymdownx.tabbed inside admonitions,
+// with a quoted label and a body indented by four spaces:
+//
+//	=== "Go"
+//	    fmt.Println("hi")
+//
+// "===!" starts a new set even right after another tab, "===+" selects a tab.
+type tabParser struct{}
+
+// Trigger implements parser.BlockParser.Trigger .
+func (b *tabParser) Trigger() []byte {
+	return []byte{'='}
+}
+
+// Open implements parser.BlockParser.Open .
+func (b *tabParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	if parent.Kind() != KindAdmonition && closestAdmonition(parent) == nil {
+		return nil, parser.NoChildren
+	}
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	match := tabOpener.FindSubmatch(line[pos:])
+	if match == nil {
+		return nil, parser.NoChildren
+	}
+
+	node := NewTab()
+	node.Label = match[2]
+	node.Selected = bytes.IndexByte(match[1], '+') >= 0
+	node.offset, _ = util.IndentWidth(line, reader.LineOffset())
+	if previous, ok := parent.LastChild().(*Tab); ok && bytes.IndexByte(match[1], '!') < 0 {
+		node.Set, node.Index = previous.Set, previous.Index+1
+	} else {
+		sets, _ := pc.Get(tabSetsKey).(int)
+		pc.Set(tabSetsKey, sets+1)
+		node.Set, node.Index = sets+1, 1
+	}
+
+	reader.Advance(segment.Len() - 1)
+	return node, parser.HasChildren
+}
+
+// Continue implements parser.BlockParser.Continue .
+func (b *tabParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, _ := reader.PeekLine()
+	if util.IsBlank(line) {
+		reader.Advance(len(line) - 1)
+		return parser.Continue | parser.HasChildren
+	}
+
+	offset := node.(*Tab).offset + mkdocsIndent
+	indent, _ := util.IndentWidth(line, reader.LineOffset())
+	if indent < offset {
+		return parser.Close
+	}
+	pos, padding := util.IndentPosition(line, reader.LineOffset(), offset)
+	reader.AdvanceAndSetPadding(pos, padding)
+	return parser.Continue | parser.HasChildren
+}
+
+// Close implements parser.BlockParser.Close .
+func (b *tabParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {}
+
+// CanInterruptParagraph implements parser.BlockParser.CanInterruptParagraph .
+func (b *tabParser) CanInterruptParagraph() bool {
+	return true
+}
+
+// CanAcceptIndentedLine implements parser.BlockParser.CanAcceptIndentedLine .
+func (b *tabParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+// tabSet returns the tabs of the set n belongs to
+func tabSet(n *Tab) []*Tab {
+	var tabs []*Tab
+	for s := n.Parent().FirstChild(); s != nil; s = s.NextSibling() {
+		if tab, ok := s.(*Tab); ok && tab.Set == n.Set {
+			tabs = append(tabs, tab)
+		}
+	}
+	return tabs
+}
+
+// renderTab renders a tab the way pymdownx.tabbed does, a set as
+// <div class="tabbed-set"> of a radio button, a label and the content of
+// every tab, so the CSS of MkDocs themes applies. Confluence gets the label
+// as a bold paragraph above the content instead.
+func (r *Renderer) renderTab(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*Tab)
+	tabs := tabSet(n)
+	if r.Target == TargetConfluence {
+		if entering {
+			_, _ = w.WriteString("<p><strong>")
+			_, _ = w.Write(util.EscapeHTML(n.Label))
+			_, _ = w.WriteString("</strong></p>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if !entering {
+		_, _ = w.WriteString("</div>\n")
+		if n == tabs[len(tabs)-1] {
+			_, _ = w.WriteString("</div>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if n == tabs[0] {
+		_, _ = fmt.Fprintf(w, "<div class=\"tabbed-set\" data-tabs=\"%d:%d\">", n.Set, len(tabs))
+	}
+	// the first tab is shown unless another one is selected
+	checked := n.Selected || n == tabs[0]
+	for _, tab := range tabs {
+		checked = checked && (tab == n || !tab.Selected)
+	}
+	id := fmt.Sprintf("__tabbed_%d_%d", n.Set, n.Index)
+	_, _ = w.WriteString("<input")
+	if checked {
+		_, _ = w.WriteString(` checked="checked"`)
+	}
+	_, _ = fmt.Fprintf(w, " id=\"%s\" name=\"__tabbed_%d\" type=\"radio\"", id, n.Set)
+	if r.XHTML {
+		_, _ = w.WriteString(" />")
+	} else {
+		_, _ = w.WriteString(">")
+	}
+	_, _ = fmt.Fprintf(w, "<label for=\"%s\">", id)
+	_, _ = w.Write(util.EscapeHTML(n.Label))
+	_, _ = w.WriteString("</label>\n<div class=\"tabbed-content\">\n")
+	return ast.WalkContinue, nil
+}
@@ -0,0 +1,63 @@
+package admonitions
+
+import (
+	"bytes"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Terminators configure how fenced admonitions, "!!!" as well as ":::"
+// directives, end. The zero value closes them with a fence at least as long
+// as the opening one, and at the end of the document or of the enclosing
+// block if there is none.
+type Terminators struct {
+	// ExactLength only closes admonitions with a fence as long as the opening
+	// one, so "!!!!" within "!!!" is a stray fence rather than its end
+	ExactLength bool
+
+	// EndKeyword closes admonitions with "!!! end" or "::: end" as well,
+	// which never opens an admonition then
+	EndKeyword bool
+
+	// Unterminated decides what happens to admonitions without a closing
+	// fence
+	Unterminated Unterminated
+}
+
+// Unterminated decides what happens to fenced admonitions without a closing
+// fence, see Terminators
+type Unterminated int
+
+const (
+	UnterminatedClose Unterminated = iota // closed at the end of the document or the enclosing block
+	UnterminatedError                     // reported as a Problem, failing the conversion
+)
+
+// endKeyword is the word closing fences carry with Terminators.EndKeyword
+var endKeyword = []byte("end")
+
+// isEndFence reports whether rest, the line after the tag characters, makes
+// the fence a closing one with the "end" keyword
+func (t Terminators) isEndFence(rest []byte) bool {
+	return t.EndKeyword && bytes.EqualFold(bytes.TrimSpace(rest), endKeyword)
+}
+
+// unterminatedTransformer reports admonitions without a closing fence as
+// Problems
+type unterminatedTransformer struct{}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *unterminatedTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	var unterminated []*Admonition
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*Admonition); ok && entering && n.unterminated {
+			unterminated = append(unterminated, n)
+		}
+		return ast.WalkContinue, nil
+	})
+	for _, n := range unterminated {
+		insertProblem(n, reader.Source(), "the %s admonition isn't closed", n.AdmonitionClass)
+	}
+}
@@ -0,0 +1,46 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_screenReaderLabels() {
+	src := []byte(`
+!!!warning Hot
+Don't touch.
+!!!
+
+!!!danger
+Really don't.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithScreenReaderLabels(map[string]string{"danger": "Achtung:"})),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <span class="sr-only">Warning:</span>
+	//   <div class="adm-title">Hot</div>
+	//   <div class="adm-body">
+	// <p>Don't touch.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-danger" data-admonition="0">
+	//   <span class="sr-only">Achtung:</span>
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Really don't.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,49 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithAbbreviations() {
+	src := []byte(`
+HTML outside of admonitions is left alone.
+
+!!!note Valid HTML
+Write HTML and CSS, not ` + "`HTML`" + `.
+!!!
+`)
+
+	abbreviations := map[string]string{
+		"HTML": "HyperText Markup Language",
+		"CSS":  "Cascading Style Sheets",
+	}
+
+	for _, opts := range [][]admonitions.Option{
+		{admonitions.WithAbbreviations(abbreviations)},
+		{admonitions.WithAbbreviations(abbreviations), admonitions.WithoutTitleAbbreviations()},
+	} {
+		markdown := goldmark.New(goldmark.WithExtensions(admonitions.New(opts...)))
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <p>HTML outside of admonitions is left alone.</p>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Valid <abbr title="HyperText Markup Language">HTML</abbr></div>
+	//   <div class="adm-body">
+	// <p>Write <abbr title="HyperText Markup Language">HTML</abbr> and <abbr title="Cascading Style Sheets">CSS</abbr>, not <code>HTML</code>.</p>
+	//   </div>
+	// </div>
+	// <p>HTML outside of admonitions is left alone.</p>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Valid HTML</div>
+	//   <div class="adm-body">
+	// <p>Write <abbr title="HyperText Markup Language">HTML</abbr> and <abbr title="Cascading Style Sheets">CSS</abbr>, not <code>HTML</code>.</p>
+	//   </div>
+	// </div>
+}
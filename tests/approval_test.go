@@ -0,0 +1,51 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_approval() {
+	src := []byte(`
+!!!danger Signed off {approved-by="Jane Doe"}
+Reviewed by legal.
+!!!
+
+!!!danger Not signed off
+Pending review.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithApprovalFooter()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	for _, n := range admonitions.FindUnapproved(doc, "danger") {
+		fmt.Printf("unapproved: %s\n", n.Title)
+	}
+
+	// Output:
+	// <div class="admonition adm-danger" data-admonition="0">
+	//   <div class="adm-title">Signed off</div>
+	//   <div class="adm-body">
+	// <p>Reviewed by legal.</p>
+	//   </div>
+	//   <div class="adm-footer">Approved by Jane Doe</div>
+	// </div>
+	// <div class="admonition adm-danger" data-admonition="0">
+	//   <div class="adm-title">Not signed off</div>
+	//   <div class="adm-body">
+	// <p>Pending review.</p>
+	//   </div>
+	// </div>
+	// unapproved: Not signed off
+}
@@ -0,0 +1,47 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_attribution() {
+	src := []byte(`
+!!!quote Proverb {source="https://go-proverbs.github.io/"}
+Clear is better than clever.
+!!!
+
+!!!note From the handbook {cite="Operations Handbook, p. 12"}
+Page the on-call engineer first.
+!!!
+`)
+
+	markdown := goldmark.New(goldmark.WithExtensions(&admonitions.Extender{}))
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	source, _ := doc.FirstChild().(*admonitions.Admonition).Source()
+	fmt.Println(source)
+
+	// Output:
+	// <div class="admonition adm-quote" data-admonition="0">
+	//   <div class="adm-title">Proverb</div>
+	//   <blockquote class="adm-body" cite="https://go-proverbs.github.io/">
+	// <p>Clear is better than clever.</p>
+	//   </blockquote>
+	//   <div class="adm-attribution">&mdash; <cite><a href="https://go-proverbs.github.io/">https://go-proverbs.github.io/</a></cite></div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">From the handbook</div>
+	//   <div class="adm-body">
+	// <p>Page the on-call engineer first.</p>
+	//   </div>
+	//   <div class="adm-attribution">&mdash; <cite>Operations Handbook, p. 12</cite></div>
+	// </div>
+	// https://go-proverbs.github.io/
+}
@@ -0,0 +1,36 @@
+package admonitions_test
+
+import (
+	"context"
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+)
+
+func Example_convertAll() {
+	inputs := []admonitions.Input{
+		{Name: "a.md", Source: []byte("!!!note A\n!!!\n")},
+		{Name: "b.md", Source: []byte("# B\n")},
+	}
+
+	outputs, err := admonitions.ConvertAll(context.Background(), inputs,
+		admonitions.WithTitleElement(admonitions.TitleSpan),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, output := range outputs {
+		fmt.Printf("%s:\n%s", output.Name, output.HTML)
+	}
+
+	// Output:
+	// a.md:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <span class="adm-title">A</span>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+	// b.md:
+	// <h1>B</h1>
+}
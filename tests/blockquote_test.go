@@ -0,0 +1,351 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_blockQuoteAdmonitions() {
+	src := []byte(`
+> [!TIP]
+> Use the *cache*.
+>
+> Really.
+
+> Just a quote
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Use the <em>cache</em>.</p>
+	// <p>Really.</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p>Just a quote</p>
+	// </blockquote>
+}
+
+func Example_blockQuoteEndMarker() {
+	src := []byte(`
+> [!TIP]
+> The tip
+> [!END]
+> and a quote after it
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndMarker)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>The tip</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p>and a quote after it</p>
+	// </blockquote>
+}
+
+func Example_blockQuoteEndAtBlankLine() {
+	src := []byte(`
+> [!TIP]
+> The tip
+>
+> and a quote after it
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndAtBlankLine)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>The tip</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p>and a quote after it</p>
+	// </blockquote>
+}
+
+func Example_blockQuoteTitle() {
+	src := []byte(`
+> [!CAUTION] Don't do *this*
+> It breaks things.
+
+> [!TIP] Just a title
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-caution" data-admonition="0">
+	//   <div class="adm-title">Don't do this</div>
+	//   <div class="adm-body">
+	// <p>It breaks things.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title">Just a title</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+}
+
+func Example_blockQuoteNested() {
+	src := []byte(`
+> [!NOTE] Outer
+> The outer body
+>
+> > [!TIP]
+> > A nested alert
+>
+> !!!warning Fenced
+> A nested admonition
+> !!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Outer</div>
+	//   <div class="adm-body">
+	// <p>The outer body</p>
+	// <div class="admonition adm-tip" data-admonition="1">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>A nested alert</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="1">
+	//   <div class="adm-title">Fenced</div>
+	//   <div class="adm-body">
+	// <p>A nested admonition</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+}
+
+func Example_blockQuoteAttributes() {
+	src := []byte(`
+> [!TIP] Shortcuts {#shortcuts .wide data-x=1}
+> Press the keys.
+
+> [!NOTE] {#untitled}
+> No title.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip wide" data-admonition="0" id="shortcuts" data-x="1">
+	//   <div class="adm-title">Shortcuts</div>
+	//   <div class="adm-body">
+	// <p>Press the keys.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0" id="untitled">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>No title.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_blockQuoteLazyContinuation() {
+	src := []byte(`
+> [!WARNING] Lazy lines
+> The paragraph goes on
+without the ">" like in any blockquote.
+>
+> This ends the admonition.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndAtBlankLine),
+				admonitions.WithSourceMap(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0" data-source-lines="2-4">
+	//   <div class="adm-title">Lazy lines</div>
+	//   <div class="adm-body">
+	// <p>The paragraph goes on
+	// without the &quot;&gt;&quot; like in any blockquote.</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p>This ends the admonition.</p>
+	// </blockquote>
+}
+
+func Example_blockQuoteFold() {
+	src := []byte(`
+> [!NOTE]+ Expanded
+> Shown until folded.
+
+> [!TIP]- Folded
+> Shown once expanded.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	open := doc.FirstChild().(*admonitions.Admonition).DefaultOpen
+	fmt.Println(open)
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// true
+	// <details class="admonition adm-note" data-admonition="0" open>
+	//   <summary class="adm-title">Expanded</summary>
+	//   <div class="adm-body">
+	// <p>Shown until folded.</p>
+	//   </div>
+	// </details>
+	// <details class="admonition adm-tip" data-admonition="0">
+	//   <summary class="adm-title">Folded</summary>
+	//   <div class="adm-body">
+	// <p>Shown once expanded.</p>
+	//   </div>
+	// </details>
+}
+
+func ExampleWithCaseSensitiveMarkers() {
+	src := []byte(`
+> [!NOTE]
+> An alert.
+
+> [!note]
+> A plain quote.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithCaseSensitiveMarkers(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>An alert.</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p>[!note]
+	// A plain quote.</p>
+	// </blockquote>
+}
+
+func ExampleWithStrictAlertMarkers() {
+	src := []byte(`
+> [! NOTE ]
+> Spaces in the marker.
+
+> [!TIP]: Colon
+> A colon following it.
+`)
+
+	for _, options := range [][]admonitions.Option{
+		{admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)},
+		{admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote), admonitions.WithStrictAlertMarkers()},
+	} {
+		markdown := goldmark.New(goldmark.WithExtensions(admonitions.New(options...)))
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Spaces in the marker.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title">Colon</div>
+	//   <div class="adm-body">
+	// <p>A colon following it.</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p>[! NOTE ]
+	// Spaces in the marker.</p>
+	// </blockquote>
+	// <blockquote>
+	// <p>A colon following it.</p>
+	// </blockquote>
+}
@@ -0,0 +1,99 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+const boldLabelSource = `
+> **Warning:** Don't touch *this*.
+> It's hot.
+
+> **Tip**: Use the cache.
+
+> **Warning:**
+> On a line of its own.
+
+> **Remember:** not a known label
+`
+
+func Example_boldLabels() {
+	src := []byte(boldLabelSource)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithBoldLabels(admonitions.BoldLabelBody),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Don't touch <em>this</em>.
+	// It's hot.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Use the cache.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>On a line of its own.</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p><strong>Remember:</strong> not a known label</p>
+	// </blockquote>
+}
+
+func Example_boldLabelTitles() {
+	src := []byte(boldLabelSource)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithBoldLabels(admonitions.BoldLabelTitle),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Don't touch this.</div>
+	//   <div class="adm-body">
+	// <p>It's hot.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title">Use the cache.</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>On a line of its own.</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p><strong>Remember:</strong> not a known label</p>
+	// </blockquote>
+}
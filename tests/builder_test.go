@@ -0,0 +1,43 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleBuilder() {
+	src := []byte(`
+> [!SECURITY]
+> Rotate the keys.
+
+!!!note Not parsed
+!!!
+`)
+
+	builder := admonitions.NewBuilder().
+		Syntax(admonitions.SyntaxGHAlerts).
+		Target(admonitions.TargetHTML).
+		Type("security", admonitions.Kind{Inherits: "warning"}).
+		Option(admonitions.WithCustomAlerts())
+	ext := builder.Build()
+	fmt.Println(ext.Features())
+
+	markdown := goldmark.New(goldmark.WithExtensions(ext))
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// [blockquotes custom-alerts kinds markers]
+	// <div class="admonition adm-security adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Rotate the keys.</p>
+	//   </div>
+	// </div>
+	// <p>!!!note Not parsed
+	// !!!</p>
+}
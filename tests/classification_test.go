@@ -0,0 +1,209 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_blockQuoteTypes() {
+	src := []byte(`
+> [!TIP]
+> Classified as a tip
+
+> Just a quote
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	pc := parser.NewContext()
+	doc := markdown.Parser().Parse(text.NewReader(src), parser.WithContext(pc))
+	types := admonitions.BlockQuoteTypes(pc)
+
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		if node.Kind() == ast.KindBlockquote {
+			fmt.Println(types.Type(node))
+		}
+	}
+
+	// Output:
+	// tip
+	// none
+}
+
+func ExampleParseBlockQuoteType() {
+	src := []byte(`
+> Just a quote,
+> and plain as most quotes are
+
+> [!CAUTION]
+> Hot surface
+
+> <div>
+> Note: legacy HTML
+> </div>
+`)
+
+	doc := goldmark.New().Parser().Parse(text.NewReader(src))
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		fmt.Println(admonitions.ParseBlockQuoteType(node, src))
+	}
+
+	// Output:
+	// none
+	// caution
+	// note
+}
+
+func ExampleParseBlockQuoteType_typographer() {
+	src := []byte(`
+> It's a "note" -- typographed
+
+> [!TIP] ...
+`)
+
+	markdown := goldmark.New(goldmark.WithExtensions(extension.Typographer))
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		fmt.Println(admonitions.ParseBlockQuoteType(node, src))
+	}
+
+	// Output:
+	// note
+	// tip
+}
+
+func BenchmarkParseBlockQuoteType(b *testing.B) {
+	src := bytes.Repeat([]byte("> Just a quote that isn't classified\n> over two lines\n\n> [!TIP]\n> A tip\n\n"), 50)
+	doc := goldmark.New().Parser().Parse(text.NewReader(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+			admonitions.ParseBlockQuoteType(node, src)
+		}
+	}
+}
+
+func ExampleGHAlertsBlockQuoteClassifier() {
+	classifier := admonitions.GHAlertsBlockQuoteClassifier()
+	for _, marker := range []string{"!NOTE", "!TIP", "!IMPORTANT", "!WARNING", "!CAUTION", "!NOTEWORTHY"} {
+		fmt.Println(marker, classifier.ClassifyingBlockQuote(marker))
+	}
+
+	// Output:
+	// !NOTE note
+	// !TIP tip
+	// !IMPORTANT important
+	// !WARNING warning
+	// !CAUTION caution
+	// !NOTEWORTHY none
+}
+
+func ExampleParseBlockQuoteType_codeSpans() {
+	src := []byte("> `[!NOTE]` starts an alert\n\n> Use ``note`` as the class\n\n> Note: `code` is fine\n")
+
+	doc := goldmark.New().Parser().Parse(text.NewReader(src))
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		fmt.Println(admonitions.ParseBlockQuoteType(node, src))
+	}
+
+	// Output:
+	// none
+	// none
+	// note
+}
+
+func ExampleWithClassificationScope() {
+	src := []byte(`
+> **Note** See the notes
+
+> See [the notes](notes.md)
+
+> Read the notes
+> first.
+
+> All fine. Take note of this.
+
+> <span>
+> note
+> </span>
+`)
+
+	for _, scope := range []admonitions.ClassificationScope{admonitions.ClassifyFirstBlock, admonitions.ClassifyFirstLine, admonitions.ClassifyFirstText, admonitions.ClassifyLeadingWord} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(admonitions.New(admonitions.WithClassificationScope(scope))),
+		)
+		pc := parser.NewContext()
+		doc := markdown.Parser().Parse(text.NewReader(src), parser.WithContext(pc))
+		types := admonitions.BlockQuoteTypes(pc)
+
+		var classified []admonitions.BlockQuoteType
+		for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+			classified = append(classified, types.Type(node))
+		}
+		fmt.Println(classified)
+	}
+
+	// Output:
+	// [note note note note note]
+	// [note note note note none]
+	// [note none note note none]
+	// [note none none none none]
+}
+
+func ExampleWithKeywordsAnywhere() {
+	src := []byte(`
+> There are multiple reasons to do this.
+
+> Note: keep it short.
+`)
+
+	for _, anywhere := range []bool{false, true} {
+		options := []admonitions.Option{admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)}
+		if anywhere {
+			options = append(options, admonitions.WithKeywordsAnywhere())
+		}
+		markdown := goldmark.New(goldmark.WithExtensions(admonitions.New(options...)))
+
+		var buf bytes.Buffer
+		if err := markdown.Convert(src, &buf); err != nil {
+			panic(err)
+		}
+		fmt.Print(buf.String())
+	}
+
+	// Output:
+	// <blockquote>
+	// <p>There are multiple reasons to do this.</p>
+	// </blockquote>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Note: keep it short.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>There are multiple reasons to do this.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Note: keep it short.</p>
+	//   </div>
+	// </div>
+}
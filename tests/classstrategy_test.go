@@ -0,0 +1,97 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+func ExampleWithClassStrategy() {
+	src := []byte(`!!!warning Careful
+Hot surface.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithClassStrategy(admonitions.BEMClasses),
+		)),
+	)
+	var buf bytes.Buffer
+	if err := markdown.Convert(src, &buf); err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+
+	// Output:
+	// <div class="admonition admonition--warning" data-admonition="0">
+	//   <div class="admonition__title admonition__title--warning">Careful</div>
+	//   <div class="admonition__body admonition__body--warning">
+	// <p>Hot surface.</p>
+	//   </div>
+	// </div>
+}
+
+func ExampleBEMClasses_inline() {
+	src := []byte("Edit the file [!tip: remember to save] and reload.\n")
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithInlineAdmonitions(),
+			admonitions.WithClassStrategy(admonitions.BEMClasses),
+		)),
+	)
+	var buf bytes.Buffer
+	if err := markdown.Convert(src, &buf); err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+
+	// Output:
+	// <p>Edit the file <span class="admonition-inline admonition-inline--tip">remember to save</span> and reload.</p>
+}
+
+func ExampleUtilityClasses() {
+	src := []byte(`!!!warning Careful
+Hot surface.
+!!!
+
+!!!note
+Just so you know.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithClassStrategy(admonitions.UtilityClasses(map[string]string{
+				"admonition":        "rounded border-l-4 p-4",
+				"adm-warning":       "border-amber-500 bg-amber-50",
+				"adm-note":          "border-sky-500 bg-sky-50",
+				"adm-title":         "font-semibold",
+				"adm-title:warning": "font-semibold text-amber-700",
+				"adm-body":          "",
+			})),
+		)),
+	)
+	var buf bytes.Buffer
+	if err := markdown.Convert(src, &buf); err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+
+	// Output:
+	// <div class="rounded border-l-4 p-4 border-amber-500 bg-amber-50" data-admonition="0">
+	//   <div class="font-semibold text-amber-700">Careful</div>
+	//   <div>
+	// <p>Hot surface.</p>
+	//   </div>
+	// </div>
+	// <div class="rounded border-l-4 p-4 border-sky-500 bg-sky-50" data-admonition="0">
+	//   <div class="font-semibold"></div>
+	//   <div>
+	// <p>Just so you know.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,77 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+// convertConcurrently converts src with markdown from several goroutines and
+// returns the outputs, run with -race to find state shared across renders
+func convertConcurrently(t *testing.T, markdown goldmark.Markdown, src string) []string {
+	t.Helper()
+	outputs := make([]string, 8)
+	var wg sync.WaitGroup
+	for i := range outputs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var buf bytes.Buffer
+			if err := markdown.Convert([]byte(src), &buf); err != nil {
+				t.Error(err)
+			}
+			outputs[i] = buf.String()
+		}(i)
+	}
+	wg.Wait()
+	for _, output := range outputs[1:] {
+		if output != outputs[0] {
+			t.Errorf("outputs differ:\n%s\n%s", outputs[0], output)
+		}
+	}
+	return outputs
+}
+
+func TestIconChainsConcurrent(t *testing.T) {
+	chains := map[string]admonitions.IconChain{
+		"note": {File: "testdata/missing.svg", Unicode: "ℹ"},
+	}
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithIconChains(chains, nil))),
+	)
+	convertConcurrently(t, markdown, "!!!note\nBody\n!!!\n")
+}
+
+func TestCollapseScriptConcurrent(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithResponsive(), admonitions.WithCollapseScript())),
+	)
+	outputs := convertConcurrently(t, markdown, "!!!note First\nBody\n!!!\n\n!!!note First\nBody\n!!!\n")
+	if got := strings.Count(outputs[0], "<script>"); got != 1 {
+		t.Errorf("got %d collapse scripts, want 1:\n%s", got, outputs[0])
+	}
+	if !strings.Contains(outputs[0], `data-adm-key="note-first-2"`) {
+		t.Errorf("repeated key isn't numbered:\n%s", outputs[0])
+	}
+}
+
+func TestAbbreviationsConcurrent(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithAbbreviations(map[string]string{"API": "Application Programming Interface"}))),
+	)
+	outputs := convertConcurrently(t, markdown, "!!!note API changes\nThe API moved.\n!!!\n")
+	if got := strings.Count(outputs[0], "<abbr "); got != 2 {
+		t.Errorf("got %d abbreviations, want 2:\n%s", got, outputs[0])
+	}
+}
+
+func TestFailFastConcurrent(t *testing.T) {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithFailFast(true))),
+	)
+	convertConcurrently(t, markdown, "!!!note\nBody with *emphasis*\n!!!\n")
+}
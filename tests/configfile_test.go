@@ -0,0 +1,151 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleFromConfig() {
+	config := `
+# the callouts of the site
+syntaxes: [fenced, gh-alerts]
+target: html
+types:
+  security:
+    inherits: warning
+aliases:
+  warn: warning
+icons:
+  security:
+    iconify: "mdi:shield"
+    unicode: "🛡"
+`
+	ext, err := admonitions.FromConfig(strings.NewReader(config))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	src := []byte(`
+> [!WARNING]
+> Careful.
+
+!!!security Keys
+Rotate them.
+!!!
+
+!!!warn Short
+!!!
+`)
+
+	markdown := goldmark.New(goldmark.WithExtensions(ext))
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Careful.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-security adm-warning" data-admonition="0">
+	//   <div class="adm-title"><img class="adm-icon" loading="lazy" alt="" src="https://api.iconify.design/mdi/shield.svg">Keys</div>
+	//   <div class="adm-body">
+	// <p>Rotate them.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Short</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+}
+
+func ExampleFromConfig_errors() {
+	for _, config := range []string{
+		`{"target": "pdf"}`,
+		`{"syntaxes": ["asciidoc"]}`,
+		`{"colour": "red"}`,
+		"types:\n  security: [warning\n",
+		`syntaxes: ["fenced, gh-alerts"]`,
+	} {
+		_, err := admonitions.FromConfig(strings.NewReader(config))
+		fmt.Println(err)
+	}
+
+	// Output:
+	// admonitions: config: unknown target "pdf"
+	// admonitions: config: unknown syntax "asciidoc"
+	// admonitions: config: json: unknown field "colour"
+	// admonitions: config: yaml: line 1: did not find expected ',' or ']'
+	// admonitions: config: unknown syntax "fenced, gh-alerts"
+}
+
+func ExampleFromConfig_quoted() {
+	config := `
+syntaxes: ["fenced", 'gh-alerts'] # both
+icons:
+  tip:
+    unicode: "\"#1\", really" # a comment
+aliases:
+  'it''s': tip
+`
+	ext, err := admonitions.FromConfig(strings.NewReader(config))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	src := []byte(`
+!!!it's Advice
+!!!
+`)
+
+	markdown := goldmark.New(goldmark.WithExtensions(ext))
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"><span class="adm-icon" aria-hidden="true">&quot;#1&quot;, really</span>Advice</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+}
+
+func ExampleFromConfig_formatted() {
+	// as written by YAML formatters: indentless sequences and flow mappings
+	config := `
+syntaxes:
+- fenced
+- gh-alerts
+aliases: {warn: warning}
+`
+	ext, err := admonitions.FromConfig(strings.NewReader(config))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	src := []byte(`
+!!!warn Short
+!!!
+`)
+
+	markdown := goldmark.New(goldmark.WithExtensions(ext))
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Short</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,75 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type quoteRenderer struct{}
+
+func (quoteRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(admonitions.KindAdmonition, func(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+		return ast.WalkContinue, nil
+	})
+}
+
+type quoteExtension struct{}
+
+func (quoteExtension) Extend(md goldmark.Markdown) {
+	md.Renderer().AddOptions(renderer.WithNodeRenderers(util.Prioritized(quoteRenderer{}, 100)))
+}
+
+func ExampleWithConflictCheck() {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithConflictCheck(func(c admonitions.Conflict) {
+				fmt.Println(c.Kind, c.Priority, len(c.Renderers))
+			})),
+			quoteExtension{},
+		),
+	)
+
+	var buf bytes.Buffer
+	_ = md.Convert([]byte("!!!note Title\nBody\n!!!\n"), &buf)
+	_ = md.Convert([]byte("!!!note Title\nBody\n!!!\n"), &buf)
+
+	// Output:
+	// Admonition 100 2
+}
+
+func ExampleWithConflictCheck_convert() {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithConflictCheck(nil)),
+			quoteExtension{},
+		),
+	)
+
+	var buf bytes.Buffer
+	fmt.Println(md.Convert([]byte("!!!note Title\nBody\n!!!\n"), &buf))
+	fmt.Println(md.Convert([]byte("No admonitions.\n"), &buf))
+
+	// Output:
+	// admonitions: Admonition is rendered by *admonitions.Renderer, admonitions_test.quoteRenderer with the same priority 100
+	// admonitions: Admonition is rendered by *admonitions.Renderer, admonitions_test.quoteRenderer with the same priority 100
+}
+
+func ExampleDetectConflicts() {
+	md := goldmark.New(goldmark.WithExtensions(&admonitions.Extender{}))
+	fmt.Println(len(admonitions.DetectConflicts(md)))
+
+	quoteExtension{}.Extend(md)
+	for _, c := range admonitions.DetectConflicts(md) {
+		fmt.Println(c)
+	}
+
+	// Output:
+	// 0
+	// admonitions: Admonition is rendered by *admonitions.Renderer, admonitions_test.quoteRenderer with the same priority 100
+}
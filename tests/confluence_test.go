@@ -0,0 +1,229 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_confluence() {
+	src := []byte(`
+!!!warning Careful
+Don't do *this*.
+!!!
+
+!!!tip
+Short tip.
+!!!
+
+!!!note
+A longer note that is too long for a status macro.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithCompactThreshold(20),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <ac:structured-macro ac:name="warning"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Careful</ac:parameter><ac:rich-text-body>
+	// <p>Don't do <em>this</em>.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <p><ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">Green</ac:parameter><ac:parameter ac:name="title">Short tip.</ac:parameter></ac:structured-macro></p>
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:rich-text-body>
+	// <p>A longer note that is too long for a status macro.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
+
+func Example_confluencePage() {
+	src := []byte("Intro\n\n!!!tip Hint\nShort tip.\n!!!\n")
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithConfluenceOutput(admonitions.ConfluencePage),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <?xml version="1.0" encoding="UTF-8"?>
+	// <ac:confluence xmlns="http://www.atlassian.com/schema/confluence/4/" xmlns:ac="http://www.atlassian.com/schema/confluence/4/ac/" xmlns:ri="http://www.atlassian.com/schema/confluence/4/ri/">
+	// <p>Intro</p>
+	// <ac:structured-macro ac:name="tip"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Hint</ac:parameter><ac:rich-text-body>
+	// <p>Short tip.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// </ac:confluence>
+}
+
+func Example_confluenceWrapped() {
+	src := []byte("!!!note\nChecked before upload.\n!!!\n")
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithConfluenceOutput(admonitions.ConfluenceWrapped),
+			),
+		),
+	)
+
+	var buf bytes.Buffer
+	_ = markdown.Convert(src, &buf)
+	os.Stdout.Write(buf.Bytes())
+
+	decoder := xml.NewDecoder(&buf)
+	for {
+		if _, err := decoder.Token(); err != nil {
+			fmt.Println(err == io.EOF)
+			break
+		}
+	}
+
+	// Output:
+	// <div xmlns:ac="http://www.atlassian.com/schema/confluence/4/ac/" xmlns:ri="http://www.atlassian.com/schema/confluence/4/ri/">
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:rich-text-body>
+	// <p>Checked before upload.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// </div>
+	// true
+}
+
+func ExampleWithConfluenceParameters() {
+	src := []byte(`
+!!!note Quiet {icon=false}
+No icon here.
+!!!
+
+!!!info Authored title {heading="Heading" width=400}
+Sized.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithConfluenceParameters(map[string]string{
+					"icon":    "icon",
+					"heading": "title",
+					"width":   "width",
+				}),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">false</ac:parameter><ac:parameter ac:name="title">Quiet</ac:parameter><ac:rich-text-body>
+	// <p>No icon here.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <ac:structured-macro ac:name="info"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Heading</ac:parameter><ac:parameter ac:name="width">400</ac:parameter><ac:rich-text-body>
+	// <p>Sized.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
+
+func ExampleWithConfluenceIcons() {
+	src := []byte(`
+!!!tip Plain
+No icon.
+!!!
+
+!!!security Locked
+Behind the VPN.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithConfluenceIcons(map[string]admonitions.ConfluenceIcon{
+					"tip":      {Hide: true},
+					"security": {Emoji: "🔒", Shortname: ":lock:", Background: "#FFEBE6"},
+				}),
+			),
+		),
+	)
+
+	var buf bytes.Buffer
+	if err := markdown.Convert(src, &buf); err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+
+	// Output:
+	// <ac:structured-macro ac:name="tip"><ac:parameter ac:name="icon">false</ac:parameter><ac:parameter ac:name="title">Plain</ac:parameter><ac:rich-text-body>
+	// <p>No icon.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <ac:structured-macro ac:name="panel"><ac:parameter ac:name="panelIcon">:lock:</ac:parameter><ac:parameter ac:name="panelIconId">1f512</ac:parameter><ac:parameter ac:name="panelIconText">🔒</ac:parameter><ac:parameter ac:name="bgColor">#FFEBE6</ac:parameter><ac:parameter ac:name="title">Locked</ac:parameter><ac:rich-text-body>
+	// <p>Behind the VPN.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
+
+func ExampleWithConfluenceIcons_iconParameter() {
+	src := []byte(`
+!!!security Locked {icon=false}
+Behind the VPN.
+!!!
+
+!!!tip Shown {icon=true}
+With its icon.
+!!!
+
+!!!release Hidden
+Without the rocket.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithConfluenceParameters(map[string]string{"icon": "icon"}),
+				admonitions.WithConfluenceIcons(map[string]admonitions.ConfluenceIcon{
+					"tip":      {Hide: true},
+					"security": {Emoji: "🔒", Shortname: ":lock:", Background: "#FFEBE6"},
+					"release":  {Emoji: "🚀", Hide: true},
+				}),
+			),
+		),
+	)
+
+	var buf bytes.Buffer
+	if err := markdown.Convert(src, &buf); err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+
+	// Output:
+	// <ac:structured-macro ac:name="info"><ac:parameter ac:name="icon">false</ac:parameter><ac:parameter ac:name="title">Locked</ac:parameter><ac:rich-text-body>
+	// <p>Behind the VPN.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <ac:structured-macro ac:name="tip"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Shown</ac:parameter><ac:rich-text-body>
+	// <p>With its icon.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <ac:structured-macro ac:name="info"><ac:parameter ac:name="icon">false</ac:parameter><ac:parameter ac:name="title">Hidden</ac:parameter><ac:rich-text-body>
+	// <p>Without the rocket.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
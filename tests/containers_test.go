@@ -0,0 +1,123 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_containers() {
+	src := []byte(`
+!!!note Top level
+Allowed.
+
+!!!tip Nested
+Not allowed.
+!!!
+!!!
+
+- In a list:
+  > [!WARNING]
+  > Not allowed either.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithContainers(func(err error) {
+					fmt.Println(err)
+				}, ast.KindDocument),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// admonitions: line 5: a tip admonition isn't allowed within Admonition, it's rendered as a blockquote
+	// admonitions: line 11: a warning admonition isn't allowed within ListItem, it's rendered as a blockquote
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Top level</div>
+	//   <div class="adm-body">
+	// <p>Allowed.</p>
+	// <blockquote>
+	// <p><strong>Nested</strong></p>
+	// <p>Not allowed.</p>
+	// </blockquote>
+	//   </div>
+	// </div>
+	// <ul>
+	// <li>In a list:
+	// <blockquote>
+	// <p>Not allowed either.</p>
+	// </blockquote>
+	// </li>
+	// </ul>
+}
+
+func Example_containersAfterAliases() {
+	src := []byte(`
+- In a list:
+
+  !!!hint
+  Not allowed.
+  !!!
+
+!!!hint
+Allowed.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTypeAliases(map[string]string{"hint": "tip"}),
+				admonitions.WithNumbering(0),
+				admonitions.WithContainers(func(err error) {
+					fmt.Println(err)
+				}, ast.KindDocument),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// admonitions: line 4: a tip admonition isn't allowed within ListItem, it's rendered as a blockquote
+	// <ul>
+	// <li>
+	// <p>In a list:</p>
+	// <blockquote>
+	// <p>Not allowed.</p>
+	// </blockquote>
+	// </li>
+	// </ul>
+	// <div class="admonition adm-tip" data-admonition="0" data-number="1">
+	//   <div class="adm-title">Tip 1</div>
+	//   <div class="adm-body">
+	// <p>Allowed.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_containersWithoutReport() {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithContainers(nil, ast.KindDocument)),
+		),
+	)
+
+	var buf bytes.Buffer
+	fmt.Println(markdown.Convert([]byte("- In a list:\n\n  !!!tip\n  Not allowed.\n  !!!\n"), &buf))
+
+	// Output:
+	// admonitions: line 3: a tip admonition isn't allowed within ListItem, it's rendered as a blockquote
+}
@@ -0,0 +1,66 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_customAlerts() {
+	src := []byte(`
+> [!BUG] Crashes on empty input
+> Fixed in v1.2, see the note below.
+
+> [!Example]
+> A custom type.
+
+> [!NOTE]
+> Built-in types are unchanged.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithCustomAlerts(),
+				admonitions.WithKinds(map[string]admonitions.Kind{"bug": {Inherits: "warning"}}),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*admonitions.Admonition); ok && entering {
+			fmt.Printf("%s: %s\n", n.AlertType, n.AdmonitionClass)
+		}
+		return ast.WalkContinue, nil
+	})
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// BUG: bug
+	// Example: example
+	// NOTE: note
+	// <div class="admonition adm-bug adm-warning" data-admonition="0">
+	//   <div class="adm-title">Crashes on empty input</div>
+	//   <div class="adm-body">
+	// <p>Fixed in v1.2, see the note below.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-example" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>A custom type.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Built-in types are unchanged.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,47 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_definitions() {
+	src := []byte(`
+!!!params Parameters
+- ` + "`ctx`" + `: the context of the *request*
+- ` + "`id`" + `: the ID of the user
+!!!
+
+!!!returns Returns
+- error: nil on success
+- the user
+!!!
+`)
+
+	markdown := goldmark.New(goldmark.WithExtensions(&admonitions.Extender{}))
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-params" data-admonition="0">
+	//   <div class="adm-title">Parameters</div>
+	//   <dl class="adm-body adm-definitions">
+	// <dt><code>ctx</code></dt>
+	// <dd>the context of the <em>request</em></dd>
+	// <dt><code>id</code></dt>
+	// <dd>the ID of the user</dd>
+	//   </dl>
+	// </div>
+	// <div class="admonition adm-returns" data-admonition="0">
+	//   <div class="adm-title">Returns</div>
+	//   <dl class="adm-body adm-definitions">
+	// <dt>error</dt>
+	// <dd>nil on success</dd>
+	// <dd>the user</dd>
+	//   </dl>
+	// </div>
+}
@@ -0,0 +1,48 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithDepthStyle() {
+	src := []byte(`
+!!!!!note Outer
+!!!!tip Middle {style="color: teal"}
+!!!warning Inner
+Deep down.
+!!!
+!!!!
+!!!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithDepthStyle()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Outer</div>
+	//   <div class="adm-body">
+	// <div style="color: teal; --adm-depth: 1" class="admonition adm-tip" data-admonition="1">
+	//   <div class="adm-title">Middle</div>
+	//   <div class="adm-body">
+	// <div class="admonition adm-warning" data-admonition="2" style="--adm-depth: 2">
+	//   <div class="adm-title">Inner</div>
+	//   <div class="adm-body">
+	// <p>Deep down.</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,61 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+// deterministicSource uses the features that used to depend on randomness or
+// map order: admonitions left open, icons, kinds and Confluence parameters
+var deterministicSource = []byte(`
+!!!note Open {#first}
+   Indented and never closed.
+
+   !!!warning Nested {width=400 icon=false}
+      Also never closed.
+
+!!!security Kind
+Body
+!!!
+
+> [!TIP]
+> A blockquote admonition.
+`)
+
+func renderDeterministic(opts ...admonitions.Option) []byte {
+	opts = append(opts,
+		admonitions.WithIcons(admonitions.DefaultIcons),
+		admonitions.WithIconSprite(),
+		admonitions.WithKinds(map[string]admonitions.Kind{"security": {Inherits: "warning"}}),
+		admonitions.WithConfluenceParameters(map[string]string{"width": "width", "icon": "icon"}),
+		admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+		admonitions.WithSourceMap(),
+	)
+	var buf bytes.Buffer
+	for _, target := range []admonitions.Target{admonitions.TargetHTML, admonitions.TargetConfluence} {
+		md := goldmark.New(goldmark.WithExtensions(admonitions.New(append(opts, admonitions.WithTarget(target))...)))
+		_ = md.Convert(deterministicSource, &buf)
+	}
+	return buf.Bytes()
+}
+
+func Example_deterministic() {
+	first := renderDeterministic()
+	same := true
+	for i := 0; i < 20; i++ {
+		same = same && bytes.Equal(first, renderDeterministic())
+	}
+	fmt.Println(same)
+	fmt.Println(bytes.Contains(first, []byte(`data-admonition="1"`)))
+
+	random := renderDeterministic(admonitions.WithRandomIDs())
+	fmt.Println(bytes.Equal(random, renderDeterministic(admonitions.WithRandomIDs())))
+
+	// Output:
+	// true
+	// true
+	// false
+}
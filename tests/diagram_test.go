@@ -0,0 +1,69 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+const diagramSource = `
+!!!note The {{name}} flow
+` + "```mermaid" + `
+graph TD;
+  API-->DB["{{name}} -- B]]>"];
+` + "```" + `
+!!!
+`
+
+// Example_diagramPassthrough renders the diagram as goldmark does, with
+// neither WithVars nor WithAbbreviations touching its source
+func Example_diagramPassthrough() {
+	src := []byte(diagramSource)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithVars(map[string]string{"name": "Checkout"}),
+				admonitions.WithAbbreviations(map[string]string{"API": "Application Programming Interface"}),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">The Checkout flow</div>
+	//   <div class="adm-body">
+	// <pre><code class="language-mermaid">graph TD;
+	//   API--&gt;DB[&quot;{{name}} -- B]]&gt;&quot;];
+	// </code></pre>
+	//   </div>
+	// </div>
+}
+
+func Example_confluenceDiagramMacros() {
+	src := []byte(diagramSource)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithConfluenceDiagramMacros(map[string]string{"mermaid": "mermaid-cloud"}),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">The {{name}} flow</ac:parameter><ac:rich-text-body>
+	// <ac:structured-macro ac:name="mermaid-cloud"><ac:plain-text-body><![CDATA[graph TD;
+	//   API-->DB["{{name}} -- B]]]]><![CDATA[>"];
+	// ]]></ac:plain-text-body></ac:structured-macro>
+	// </ac:rich-text-body></ac:structured-macro>
+}
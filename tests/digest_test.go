@@ -0,0 +1,146 @@
+package admonitions_test
+
+import (
+	"os"
+	"testing/fstest"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+func Example_digest() {
+	fsys := fstest.MapFS{
+		"index.md": {Data: []byte(`# Index
+
+!!!danger Don't run this as root
+It deletes everything.
+!!!
+
+!!!note Not part of the digest
+!!!
+`)},
+		"guide/setup.md": {Data: []byte(`!!!!warning Nested
+Outer
+!!!note Inner
+!!!
+!!!!
+`)},
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	entries, _ := admonitions.ExtractAdmonitions(fsys, markdown, func(entry admonitions.DigestEntry) bool {
+		return entry.Type != "note"
+	})
+	_ = admonitions.WriteDigest(os.Stdout, "Known issues and cautions", entries)
+
+	// Output:
+	// # Known issues and cautions
+	//
+	// ## danger
+	//
+	// !!!danger Don't run this as root
+	// It deletes everything.
+	// !!!
+	//
+	// Source: [index.md, line 3](index.md#L3)
+	//
+	// ## warning
+	//
+	// !!!!warning Nested
+	// Outer
+	// !!!note Inner
+	// !!!
+	// !!!!
+	//
+	// Source: [guide/setup.md, line 1](guide/setup.md#L1)
+}
+
+func Example_digestAlerts() {
+	fsys := fstest.MapFS{
+		"index.md": {Data: []byte(`> [!WARNING] Careful
+> Don't run this as root.
+>
+> > [!TIP]
+> > Use sudo.
+`)},
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	entries, _ := admonitions.ExtractAdmonitions(fsys, markdown, nil)
+	_ = admonitions.WriteDigest(os.Stdout, "Alerts", entries)
+
+	// Output:
+	// # Alerts
+	//
+	// ## tip
+	//
+	// !!!tip
+	// Use sudo.
+	// !!!
+	//
+	// Source: [index.md, line 4](index.md#L4)
+	//
+	// ## warning
+	//
+	// !!!warning Careful
+	// Don't run this as root.
+	//
+	// > [!TIP]
+	// > Use sudo.
+	// !!!
+	//
+	// Source: [index.md, line 1](index.md#L1)
+}
+
+func Example_digestAlertPositions() {
+	fsys := fstest.MapFS{
+		"alert.md": {Data: []byte(`Intro
+
+> [!WARNING]
+> Don't run this as root.
+`)},
+		// no opening line, the alert starts with a heading
+		"heading.md": {Data: []byte(`Intro
+
+> ### [!WARNING]
+> Don't run this as root.
+`)},
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	entries, _ := admonitions.ExtractAdmonitions(fsys, markdown, nil)
+	_ = admonitions.WriteDigest(os.Stdout, "Alerts", entries)
+
+	// Output:
+	// # Alerts
+	//
+	// ## warning
+	//
+	// !!!warning
+	// Don't run this as root.
+	// !!!
+	//
+	// Source: [alert.md, line 3](alert.md#L3)
+	//
+	// !!!warning
+	// ### [!WARNING]
+	// Don't run this as root.
+	// !!!
+	//
+	// Source: [heading.md, line 3](heading.md#L3)
+}
@@ -0,0 +1,58 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_directives() {
+	src := []byte(`
+:::warning[Careful [really]]{#careful}
+This is the *body*.
+:::
+
+::::note Nesting works like with bangs
+:::tip
+A nested tip.
+:::
+::::
+
+:::
+Not an admonition
+:::
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithDirectives()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div id="careful" class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Careful [really]</div>
+	//   <div class="adm-body">
+	// <p>This is the <em>body</em>.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Nesting works like with bangs</div>
+	//   <div class="adm-body">
+	// <div class="admonition adm-tip" data-admonition="1">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>A nested tip.</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+	// <p>:::
+	// Not an admonition
+	// :::</p>
+}
@@ -0,0 +1,37 @@
+package admonitions_test
+
+import (
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func ExampleDoctor() {
+	healthy := goldmark.New(goldmark.WithExtensions(admonitions.New()))
+	fmt.Println(len(admonitions.Doctor(healthy)))
+
+	missing := goldmark.New()
+	for _, problem := range admonitions.Doctor(missing) {
+		fmt.Println(problem.Err)
+	}
+
+	unsafe := goldmark.New(goldmark.WithExtensions(admonitions.New(admonitions.WithUnsafe())))
+	for _, problem := range admonitions.Doctor(unsafe) {
+		fmt.Println(problem.Err)
+	}
+
+	htmlUnsafe := goldmark.New(
+		goldmark.WithExtensions(admonitions.New()),
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+	// raw admonitions are omitted, but raw HTML in admonitions isn't
+	fmt.Println(len(admonitions.Doctor(htmlUnsafe)))
+
+	// Output:
+	// 0
+	// admonitions: admonitions aren't parsed, the Extender isn't registered with this goldmark instance
+	// admonitions: admonitions.WithUnsafe is set but html.WithUnsafe isn't, raw HTML in admonitions is omitted
+	// 0
+}
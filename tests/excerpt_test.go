@@ -0,0 +1,42 @@
+package admonitions_test
+
+import (
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_excerpt() {
+	src := []byte(`
+# Installation
+
+!!!danger Outdated
+This page is outdated.
+!!!
+
+Install the package with *go get*.
+
+!!!tip Faster
+Use a module proxy.
+!!!
+
+Then import it and add the extension to goldmark.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+
+	fmt.Println(admonitions.Excerpt(doc, src, 0, admonitions.SkipAdmonitions))
+	fmt.Println(admonitions.Excerpt(doc, src, 12, admonitions.FirstTipOnly))
+
+	// Output:
+	// Install the package with go get. Then import it and add the extension to goldmark.
+	// Install the package with go get. Faster Use a module proxy. Then…
+}
@@ -0,0 +1,109 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_expiry() {
+	src := []byte(`
+!!!warning Migration {expires="2025-06-30"}
+The old API goes away.
+!!!
+
+!!!note Current {expires="2025-07-01"}
+Still shown.
+!!!
+`)
+
+	clock := func() time.Time { return time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC) }
+	for _, expiry := range []admonitions.Expiry{admonitions.ExpiryHide, admonitions.ExpiryArchive} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(admonitions.WithExpiry(expiry), admonitions.WithClock(clock)),
+			),
+		)
+
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Current</div>
+	//   <div class="adm-body">
+	// <p>Still shown.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning adm-archived" data-admonition="0">
+	//   <div class="adm-title">Migration</div>
+	//   <div class="adm-body">
+	// <p>The old API goes away.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Current</div>
+	//   <div class="adm-body">
+	// <p>Still shown.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_expiryUnquoted() {
+	src := []byte(`
+!!!warning Migration {expires=2025-06-30 #migration}
+The old API goes away.
+!!!
+
+> [!NOTE] Current {expires=2025-07-01}
+> Still shown.
+`)
+
+	clock := func() time.Time { return time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC) }
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithExpiry(admonitions.ExpiryHide),
+				admonitions.WithClock(clock),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Current</div>
+	//   <div class="adm-body">
+	// <p>Still shown.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_expiryInvalid() {
+	src := []byte("!!!warning Migration {expires=2025-13-01}\nThe old API goes away.\n!!!\n")
+
+	for _, failFast := range []bool{false, true} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(admonitions.WithExpiry(admonitions.ExpiryHide), admonitions.WithFailFast(failFast)),
+			),
+		)
+
+		var buf bytes.Buffer
+		err := markdown.Convert(src, &buf)
+		fmt.Println(err)
+	}
+
+	// Output:
+	// <nil>
+	// admonitions: line 1: the expires attribute "2025-13-01" isn't a date like 2025-06-30
+}
@@ -0,0 +1,38 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+func Example_failFast() {
+	sources := []string{
+		"> [!NOTE]\n> Fine.\n",
+		"# Setup\n\n> [!NOTICE]\n> A typo.\n",
+		"!!!note Version {{version}}\nThe {{release}} is out.\n!!!\n",
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithVars(map[string]string{"version": "1.2"}),
+				admonitions.WithFailFast(true),
+			),
+		),
+	)
+
+	for _, src := range sources {
+		var buf bytes.Buffer
+		err := markdown.Convert([]byte(src), &buf)
+		fmt.Println(err)
+	}
+
+	// Output:
+	// <nil>
+	// admonitions: line 3: unknown alert type "NOTICE"
+	// admonitions: line 1: unknown placeholder {{release}}
+}
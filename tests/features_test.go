@@ -0,0 +1,131 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	gast "github.com/yuin/goldmark/ast"
+)
+
+func ExampleExtender_Features() {
+	extension := admonitions.New(
+		admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+		admonitions.WithIcons(admonitions.DefaultIcons),
+		admonitions.WithTarget(admonitions.TargetConfluence),
+	)
+
+	fmt.Println(extension.Features())
+	fmt.Println(admonitions.New().Features())
+
+	// Output:
+	// [blockquotes confluence icons]
+	// []
+}
+
+func ExampleVersion() {
+	// e.g. v1.2.0, or (devel) when built from a checkout
+	fmt.Println(admonitions.Version() != "")
+
+	// Output:
+	// true
+}
+
+// TestFeaturesCoverEveryOption checks that every option of the package enables
+// a feature, so new options can't be left out of Features
+func TestFeaturesCoverEveryOption(t *testing.T) {
+	options := map[string]admonitions.Option{
+		"WithPriority":                admonitions.WithPriority(200),
+		"WithTitleElement":            admonitions.WithTitleElement(admonitions.TitleHeading),
+		"WithTitleLevel":              admonitions.WithTitleLevel(3),
+		"WithTitleFunc":               admonitions.WithTitleFunc(func(n *admonitions.Admonition) string { return "" }),
+		"WithTitleFilter":             admonitions.WithTitleFilter(strings.ToUpper),
+		"WithResponsive":              admonitions.WithResponsive(),
+		"WithWordLimit":               admonitions.WithWordLimit(100),
+		"WithCollapseScript":          admonitions.WithCollapseScript(),
+		"WithFigures":                 admonitions.WithFigures(),
+		"WithApprovalFooter":          admonitions.WithApprovalFooter(),
+		"WithVars":                    admonitions.WithVars(map[string]string{}),
+		"WithFlags":                   admonitions.WithFlags("beta"),
+		"WithIcons":                   admonitions.WithIcons(admonitions.DefaultIcons),
+		"WithIconSprite":              admonitions.WithIconSprite(),
+		"WithIconURLs":                admonitions.WithIconURLs("/icons/", nil),
+		"WithIconChains":              admonitions.WithIconChains(map[string]admonitions.IconChain{}, nil),
+		"WithAbbreviations":           admonitions.WithAbbreviations(map[string]string{}),
+		"WithExpiry":                  admonitions.WithExpiry(admonitions.ExpiryHide),
+		"WithStats":                   admonitions.WithStats(&admonitions.Stats{}),
+		"WithClock":                   admonitions.WithClock(time.Now),
+		"WithTicketLinks":             admonitions.WithTicketLinks(admonitions.TicketLink{Pattern: regexp.MustCompile(`#\d+`)}),
+		"WithoutTitleAbbreviations":   admonitions.WithoutTitleAbbreviations(),
+		"WithScreenReaderLabels":      admonitions.WithScreenReaderLabels(nil),
+		"WithKinds":                   admonitions.WithKinds(map[string]admonitions.Kind{}),
+		"WithRestrictions":            admonitions.WithRestrictions(admonitions.Restrictions{}),
+		"WithContentTabs":             admonitions.WithContentTabs(),
+		"WithNumbering":               admonitions.WithNumbering(0),
+		"WithTypeAliases":             admonitions.WithTypeAliases(map[string]string{}),
+		"WithTarget":                  admonitions.WithTarget(admonitions.TargetConfluence),
+		"WithComponentTag":            admonitions.WithComponentTag("acme-callout"),
+		"WithConfluenceOutput":        admonitions.WithConfluenceOutput(admonitions.ConfluencePage),
+		"WithConfluenceParameters":    admonitions.WithConfluenceParameters(map[string]string{}),
+		"WithSeverityMacros":          admonitions.WithSeverityMacros(map[int]string{}),
+		"WithConfluenceIcons":         admonitions.WithConfluenceIcons(map[string]admonitions.ConfluenceIcon{}),
+		"WithConfluenceDiagramMacros": admonitions.WithConfluenceDiagramMacros(map[string]string{}),
+		"WithCompactThreshold":        admonitions.WithCompactThreshold(80),
+		"WithUnsafe":                  admonitions.WithUnsafe(),
+		"WithMetadata":                admonitions.WithMetadata(),
+		"WithClassScope":              admonitions.WithClassScope("docs"),
+		"WithClassStrategy":           admonitions.WithClassStrategy(admonitions.BEMClasses),
+		"WithUnwrap":                  admonitions.WithUnwrap(true),
+		"WithRandomIDs":               admonitions.WithRandomIDs(),
+		"WithFailFast":                admonitions.WithFailFast(true),
+		"WithSourceMap":               admonitions.WithSourceMap(),
+		"WithMarkers":                 admonitions.WithMarkers("!!!"),
+		"WithMkDocs":                  admonitions.WithMkDocs(),
+		"WithOptionsLine":             admonitions.WithOptionsLine(),
+		"WithTerminators":             admonitions.WithTerminators(admonitions.Terminators{EndKeyword: true}),
+		"WithPandocDivs":              admonitions.WithPandocDivs(),
+		"WithInlineAdmonitions":       admonitions.WithInlineAdmonitions(),
+		"WithReStructuredText":        admonitions.WithReStructuredText(),
+		"WithDirectives":              admonitions.WithDirectives(),
+		"WithBlockQuoteAdmonitions":   admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+		"WithBoldLabels":              admonitions.WithBoldLabels(admonitions.BoldLabelTitle),
+		"WithCustomAlerts":            admonitions.WithCustomAlerts(),
+		"WithCaseSensitiveMarkers":    admonitions.WithCaseSensitiveMarkers(),
+		"WithStrictAlertMarkers":      admonitions.WithStrictAlertMarkers(),
+		"WithClassificationScope":     admonitions.WithClassificationScope(admonitions.ClassifyLeadingWord),
+		"WithKeywordsAnywhere":        admonitions.WithKeywordsAnywhere(),
+		"WithStepAlerts":              admonitions.WithStepAlerts(nil),
+		"WithExactMarkers":            admonitions.WithExactMarkers(),
+		"WithDepthStyle":              admonitions.WithDepthStyle(),
+		"WithCompactTitleOnly":        admonitions.WithCompactTitleOnly(),
+		"WithContainers":              admonitions.WithContainers(nil, gast.KindDocument),
+		"WithConflictCheck":           admonitions.WithConflictCheck(nil),
+	}
+
+	packages, err := parser.ParseDir(token.NewFileSet(), "..", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range packages["admonitions"].Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "With") || !fn.Name.IsExported() {
+				continue
+			}
+			option, ok := options[fn.Name.Name]
+			if !ok {
+				t.Errorf("%s: missing from this test", fn.Name.Name)
+				continue
+			}
+			if features := admonitions.New(option).Features(); len(features) == 0 {
+				t.Errorf("%s: enables no feature", fn.Name.Name)
+			}
+		}
+	}
+}
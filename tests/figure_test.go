@@ -0,0 +1,49 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_figures() {
+	src := []byte(`
+!!!note The settings dialog
+![Settings](settings.png)
+!!!
+
+!!!tip
+[![Zoom](zoom.png)](zoom-large.png)
+!!!
+
+!!!note Not a figure
+![Settings](settings.png) with text
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithFigures()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <figure class="admonition adm-note" data-admonition="0">
+	//   <img src="settings.png" alt="Settings">
+	//   <figcaption class="adm-title">The settings dialog</figcaption>
+	// </figure>
+	// <figure class="admonition adm-tip" data-admonition="0">
+	//   <a href="zoom-large.png"><img src="zoom.png" alt="Zoom"></a>
+	// </figure>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Not a figure</div>
+	//   <div class="adm-body">
+	// <p><img src="settings.png" alt="Settings"> with text</p>
+	//   </div>
+	// </div>
+}
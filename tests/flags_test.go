@@ -0,0 +1,48 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_flags() {
+	src := []byte(`
+!!!note Beta only {if=beta}
+Try the new API.
+!!!
+
+!!!note Stable only {if="!beta"}
+The API is stable.
+!!!
+
+!!!note Everyone
+Always shown.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithFlags("beta")),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Beta only</div>
+	//   <div class="adm-body">
+	// <p>Try the new API.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Everyone</div>
+	//   <div class="adm-body">
+	// <p>Always shown.</p>
+	//   </div>
+	// </div>
+}
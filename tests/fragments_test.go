@@ -0,0 +1,42 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+)
+
+func Example_convertFragments() {
+	fragments := []admonitions.Fragment{
+		{Name: "intro.md", Source: []byte("> [!TIP]\n> Written for GitHub.\n")},
+		{
+			Name:    "vendor/usage.md",
+			Source:  []byte("!!! warning \"Vendored\"\n    Written for MkDocs.\n"),
+			Options: []admonitions.Option{admonitions.WithMkDocs()},
+		},
+		{Name: "outro.md", Source: []byte("??? tip \"MkDocs only\"\n    isn't parsed here.\n")},
+	}
+
+	err := admonitions.ConvertFragments(os.Stdout, fragments,
+		admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Written for GitHub.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Vendored</div>
+	//   <div class="adm-body">
+	// <p>Written for MkDocs.</p>
+	//   </div>
+	// </div>
+	// <p>??? tip &quot;MkDocs only&quot;
+	// isn't parsed here.</p>
+}
@@ -0,0 +1,163 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+func ExampleFromConfluence() {
+	storage := []byte(`<p>Before the <strong>macros</strong>.</p>
+<ac:structured-macro ac:name="warning"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Careful</ac:parameter><ac:rich-text-body>
+<p>Don't do <em>this</em>, see <a href="https://example.com/">the docs</a>.</p>
+<ul><li>one</li><li>R&amp;D</li></ul>
+</ac:rich-text-body></ac:structured-macro>
+<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">go</ac:parameter><ac:plain-text-body><![CDATA[fmt.Println("hi")]]></ac:plain-text-body></ac:structured-macro>`)
+
+	markdown, err := admonitions.FromConfluence(storage)
+	if err != nil {
+		fmt.Println(err)
+	}
+	os.Stdout.Write(markdown)
+
+	// Output:
+	// Before the **macros**.
+	//
+	// > [!WARNING]
+	// > **Careful**
+	// >
+	// > Don't do *this*, see [the docs](https://example.com/).
+	// >
+	// > - one
+	// > - R&D
+	//
+	// ```go
+	// fmt.Println("hi")
+	// ```
+}
+
+func ExampleFromConfluence_roundTrip() {
+	src := []byte("!!!tip Hint\nUse `go vet`.\n!!!\n")
+
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithConfluenceOutput(admonitions.ConfluencePage),
+			),
+		),
+	)
+	var storage bytes.Buffer
+	_ = md.Convert(src, &storage)
+
+	markdown, _ := admonitions.FromConfluence(storage.Bytes())
+	os.Stdout.Write(markdown)
+
+	// Output:
+	// > [!TIP]
+	// > **Hint**
+	// >
+	// > Use `go vet`.
+}
+
+func TestFromConfluenceRoundTrip(t *testing.T) {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithTarget(admonitions.TargetConfluence),
+			),
+		),
+	)
+	for alert, want := range map[string]string{
+		"NOTE":      "NOTE",
+		"TIP":       "TIP",
+		"IMPORTANT": "IMPORTANT",
+		"WARNING":   "WARNING",
+		// Confluence has no macro for cautions but the warning macro
+		"CAUTION": "WARNING",
+	} {
+		var storage bytes.Buffer
+		if err := md.Convert([]byte("> [!"+alert+"]\n> Body\n"), &storage); err != nil {
+			t.Fatal(err)
+		}
+		markdown, err := admonitions.FromConfluence(storage.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := "> [!" + want + "]\n> Body\n"; string(markdown) != got {
+			t.Errorf("%s: got %q, want %q", alert, markdown, got)
+		}
+	}
+}
+
+func ExampleFromConfluence_escaping() {
+	storage := []byte(`<p># not a heading, 2*3*4 and &lt;b&gt;</p>
+<p>1. not a list, [not](a link) and a_b_c</p>
+<ol><li>a<ul><li>nested <em>deeper</em></li><li>- dash</li></ul></li><li>b</li></ol>`)
+
+	markdown, err := admonitions.FromConfluence(storage)
+	if err != nil {
+		fmt.Println(err)
+	}
+	os.Stdout.Write(markdown)
+
+	// rendered again, the texts and lists are the same as in Confluence
+	fmt.Println()
+	_ = goldmark.New().Convert(markdown, os.Stdout)
+
+	// Output:
+	// \# not a heading, 2\*3\*4 and \<b>
+	//
+	// 1\. not a list, \[not\](a link) and a\_b\_c
+	//
+	// 1. a
+	//    - nested *deeper*
+	//    - \- dash
+	// 2. b
+	//
+	// <p># not a heading, 2*3*4 and &lt;b&gt;</p>
+	// <p>1. not a list, [not](a link) and a_b_c</p>
+	// <ol>
+	// <li>a
+	// <ul>
+	// <li>nested <em>deeper</em></li>
+	// <li>- dash</li>
+	// </ul>
+	// </li>
+	// <li>b</li>
+	// </ol>
+}
+
+func TestFromConfluenceRoundTripMarkdown(t *testing.T) {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithTarget(admonitions.TargetConfluence),
+			),
+		),
+	)
+	for _, src := range []string{
+		"\\# not a heading, 2\\*3\\*4 and \\<b>\n",
+		"> [!NOTE]\n> \\> not a quote, a\\_b and \\`tick\\`\n",
+		"- a\n  - nested\n  - \\- dash\n- b\n",
+		"1. one\n2. two\n   1. nested\n",
+	} {
+		var storage bytes.Buffer
+		if err := md.Convert([]byte(src), &storage); err != nil {
+			t.Fatal(err)
+		}
+		markdown, err := admonitions.FromConfluence(storage.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(markdown) != src {
+			t.Errorf("%q: got %q", src, markdown)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// knownDivergences are the documents of testdata/github rendered differently
+// than GitHub does, with the reason
+var knownDivergences = map[string]string{
+	"lists": "GitHub only turns quotes outside of lists into alerts",
+}
+
+// TestGitHubCorpus renders the READMEs of testdata/github and compares them
+// with the HTML GitHub renders for them, kept next to them and refreshed by
+// testdata/github/regenerate.sh. Both are
+// reduced to their text, alerts and quotes first, so only differences a
+// reader sees are reported.
+func TestGitHubCorpus(t *testing.T) {
+	sources, err := filepath.Glob(filepath.Join("testdata", "github", "*.md"))
+	if err != nil || len(sources) == 0 {
+		t.Fatalf("no corpus: %v", err)
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithTitleFunc(githubTitle),
+			),
+		),
+	)
+
+	for _, source := range sources {
+		source := source
+		name := strings.TrimSuffix(filepath.Base(source), ".md")
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile(source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			captured, err := os.ReadFile(strings.TrimSuffix(source, ".md") + ".html")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err := markdown.Convert(src, &buf); err != nil {
+				t.Fatalf("Convert failed: %v", err)
+			}
+
+			want, err := normalizeHTML(captured, githubAlert)
+			if err != nil {
+				t.Fatalf("the captured HTML is broken: %v", err)
+			}
+			got, err := normalizeHTML(buf.Bytes(), ourAlert)
+			if err != nil {
+				t.Fatalf("the output is broken: %v\n%s", err, buf.String())
+			}
+
+			reason, known := knownDivergences[name]
+			switch {
+			case got != want && known:
+				t.Logf("diverges from GitHub, %s:\nwant:\n%s\ngot:\n%s", reason, want, got)
+			case got != want:
+				t.Errorf("diverges from GitHub:\nwant:\n%s\ngot:\n%s", want, got)
+			case known:
+				t.Errorf("renders like GitHub now, remove it from knownDivergences")
+			}
+		})
+	}
+}
+
+// githubTitle titles alerts without a title like GitHub does, "Note"
+func githubTitle(n *admonitions.Admonition) string {
+	if len(n.Title) > 0 || len(n.AdmonitionClass) == 0 {
+		return string(n.Title)
+	}
+	class := string(n.AdmonitionClass)
+	return strings.ToUpper(class[:1]) + class[1:]
+}
+
+// classes returns the classes of element
+func classes(element xml.StartElement) []string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == "class" {
+			return strings.Fields(attr.Value)
+		}
+	}
+	return nil
+}
+
+// githubAlert returns the type of the alert element starts and whether it is
+// one, and whether it is the title of one
+func githubAlert(element xml.StartElement) (kind string, title bool) {
+	for _, class := range classes(element) {
+		if class == "markdown-alert-title" {
+			return "", true
+		}
+		if strings.HasPrefix(class, "markdown-alert-") {
+			kind = strings.TrimPrefix(class, "markdown-alert-")
+		}
+	}
+	return kind, false
+}
+
+// ourAlert is githubAlert for the output of the Renderer
+func ourAlert(element xml.StartElement) (kind string, title bool) {
+	list := classes(element)
+	admonition := false
+	for _, class := range list {
+		if class == "adm-title" {
+			return "", true
+		}
+		admonition = admonition || class == "admonition"
+	}
+	if !admonition {
+		return "", false
+	}
+	for _, class := range list {
+		if strings.HasPrefix(class, "adm-") {
+			kind = strings.TrimPrefix(class, "adm-")
+		}
+	}
+	return kind, false
+}
+
+// normalizedBlocks are the elements starting a line of normalized HTML
+var normalizedBlocks = map[string]bool{
+	"p": true, "div": true, "li": true, "pre": true, "ul": true, "ol": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"table": true, "tr": true, "hr": true,
+}
+
+// normalizeHTML reduces src to a line for every block of text, whitespace
+// collapsed and code outside of <pre> in backticks, and to lines marking the start and
+// end of alerts, titles and quotes. Icons and heading anchors are dropped.
+func normalizeHTML(src []byte, alert func(xml.StartElement) (string, bool)) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(append(append([]byte("<root>"), src...), "</root>"...)))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	var (
+		lines []string
+		text  strings.Builder
+		// the ends of the open elements, "" for those without one, "pre"
+		// for <pre>
+		ends    []string
+		skipped int
+	)
+	flush := func(prefix string) {
+		if line := strings.Join(strings.Fields(text.String()), " "); line != "" {
+			lines = append(lines, prefix+line)
+		}
+		text.Reset()
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			if skipped > 0 || token.Name.Local == "svg" || (token.Name.Local == "a" && contains(classes(token), "anchor")) {
+				skipped++
+				continue
+			}
+			kind, title := alert(token)
+			end := ""
+			switch {
+			case title:
+				flush("")
+				end = "title"
+			case kind != "":
+				flush("")
+				lines = append(lines, "alert "+kind)
+				end = "end alert"
+			case token.Name.Local == "blockquote":
+				flush("")
+				lines = append(lines, "quote")
+				end = "end quote"
+			case token.Name.Local == "code" && !contains(ends, "pre"):
+				text.WriteString("`")
+				end = "`"
+			case token.Name.Local == "pre":
+				flush("")
+				end = "pre"
+			case normalizedBlocks[token.Name.Local]:
+				flush("")
+			}
+			ends = append(ends, end)
+		case xml.EndElement:
+			if skipped > 0 {
+				skipped--
+				continue
+			}
+			end := ends[len(ends)-1]
+			ends = ends[:len(ends)-1]
+			switch {
+			case end == "title":
+				flush("title ")
+			case end == "`":
+				text.WriteString("`")
+			case end == "pre":
+				flush("")
+			case end != "":
+				flush("")
+				lines = append(lines, end)
+			case normalizedBlocks[token.Name.Local]:
+				flush("")
+			}
+		case xml.CharData:
+			if skipped == 0 {
+				text.Write(token)
+			}
+		}
+	}
+	flush("")
+	return strings.Join(lines, "\n"), nil
+}
+
+// contains reports whether list contains s
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package admonitions_test
+
+import (
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_hash() {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	hash := func(src string) string {
+		source := []byte(src)
+		doc := markdown.Parser().Parse(text.NewReader(source))
+		return doc.FirstChild().(*admonitions.Admonition).Hash(source)
+	}
+
+	original := hash("!!!danger Security\nNever share your *tok*en.\n!!!\n")
+	reformatted := hash("!!!danger   Security\nNever share\nyour **tok**en.\n!!!\n")
+	reworded := hash("!!!danger Security\nNever share your password.\n!!!\n")
+
+	fmt.Println(original == reformatted)
+	fmt.Println(original == reworded)
+
+	// links and images differing only in their destination
+	link := hash("!!!danger Security\nSee [the policy](https://example.com/policy).\n!!!\n")
+	relinked := hash("!!!danger Security\nSee [the policy](https://evil.example.com/).\n!!!\n")
+	image := hash("!!!danger Security\n![Badge](badge.svg)\n!!!\n")
+	reimaged := hash("!!!danger Security\n![Badge](other.svg)\n!!!\n")
+	autolink := hash("!!!danger Security\nSee <https://example.com/policy>.\n!!!\n")
+	reautolinked := hash("!!!danger Security\nSee <https://evil.example.com/>.\n!!!\n")
+	fmt.Println(link == relinked, image == reimaged, autolink == reautolinked)
+
+	// nested admonitions differing only in their title
+	nested := hash("!!!!danger Security\n!!!note Tokens\nNever share them.\n!!!\n!!!!\n")
+	retitled := hash("!!!!danger Security\n!!!note Passwords\nNever share them.\n!!!\n!!!!\n")
+	fmt.Println(nested == retitled)
+
+	// Output:
+	// true
+	// false
+	// false false false
+	// false
+}
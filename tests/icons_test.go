@@ -0,0 +1,161 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_iconSprite() {
+	src := []byte(`
+!!!tip First
+!!!
+
+!!!tip Second
+!!!
+
+!!!custom Without icon
+!!!
+`)
+
+	icons := map[string]admonitions.Icon{
+		"tip": {ViewBox: "0 0 24 24", Content: `<circle cx="12" cy="12" r="10"/>`},
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithIcons(icons),
+				admonitions.WithIconSprite(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"><svg xmlns="http://www.w3.org/2000/svg" fill="currentColor" style="display: none"><symbol id="adm-icon-tip" viewBox="0 0 24 24"><circle cx="12" cy="12" r="10"/></symbol></svg><svg class="adm-icon" aria-hidden="true"><use href="#adm-icon-tip"></use></svg>First</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"><svg class="adm-icon" aria-hidden="true"><use href="#adm-icon-tip"></use></svg>Second</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+	// <div class="admonition adm-custom" data-admonition="0">
+	//   <div class="adm-title">Without icon</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+}
+
+func Example_iconURLs() {
+	src := []byte(`
+!!!note From the base URL
+!!!
+
+!!!danger Mapped explicitly
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithIconURLs("https://cdn.example.com/icons/", map[string]string{
+					"danger": "https://cdn.example.com/alert.svg",
+				}),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"><img class="adm-icon" loading="lazy" alt="" src="https://cdn.example.com/icons/note.svg">From the base URL</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+	// <div class="admonition adm-danger" data-admonition="0">
+	//   <div class="adm-title"><img class="adm-icon" loading="lazy" alt="" src="https://cdn.example.com/alert.svg">Mapped explicitly</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+}
+
+func ExampleWithIconChains() {
+	src := []byte(`
+!!!note Offline
+The file is missing, Iconify unreachable.
+!!!
+
+!!!tip Online
+!!!
+`)
+
+	chains := map[string]admonitions.IconChain{
+		"note": {File: "/icons/note.svg", Iconify: "mdi:information", Unicode: "ℹ"},
+		"tip":  {File: "/icons/tip.svg", Iconify: "mdi:lightbulb", Unicode: "💡"},
+	}
+	// only tips may load from Iconify
+	available := func(src string) bool {
+		return src == "https://api.iconify.design/mdi/lightbulb.svg"
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithIconChains(chains, available)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"><span class="adm-icon" aria-hidden="true">ℹ</span>Offline</div>
+	//   <div class="adm-body">
+	// <p>The file is missing, Iconify unreachable.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"><img class="adm-icon" loading="lazy" alt="" src="https://api.iconify.design/mdi/lightbulb.svg">Online</div>
+	//   <div class="adm-body">
+	//   </div>
+	// </div>
+}
+
+func TestIconSpriteRenderedTwice(t *testing.T) {
+	src := []byte("!!!tip First\n!!!\n\n!!!tip Second\n!!!\n")
+	icons := map[string]admonitions.Icon{
+		"tip": {ViewBox: "0 0 24 24", Content: `<circle cx="12" cy="12" r="10"/>`},
+	}
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithIcons(icons), admonitions.WithIconSprite())),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		if err := markdown.Renderer().Render(&buf, src, doc); err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Count(buf.String(), "<symbol "); got != 1 {
+			t.Errorf("render %d: got %d sprite symbols, want 1:\n%s", i+1, got, buf.String())
+		}
+	}
+
+	outputs := convertConcurrently(t, markdown, string(src))
+	if got := strings.Count(outputs[0], "<symbol "); got != 1 {
+		t.Errorf("got %d sprite symbols, want 1:\n%s", got, outputs[0])
+	}
+}
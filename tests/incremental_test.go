@@ -0,0 +1,67 @@
+package admonitions_test
+
+import (
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_reclassify() {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	before := []byte("> [!TIP]\n> a tip\n\n> a quote\n")
+	pc := parser.NewContext()
+	markdown.Parser().Parse(text.NewReader(before), parser.WithContext(pc))
+	types := admonitions.BlockQuoteTypes(pc)
+
+	// The user adds a "[!WARNING]" line in front of "a quote"
+	after := []byte("> [!TIP]\n> a tip\n\n> [!WARNING]\n> a quote\n")
+	edit := admonitions.Edit{Start: 20, Stop: 20, NewLength: len("[!WARNING]\n> ")}
+	doc := markdown.Parser().Parse(text.NewReader(after))
+	types = types.Reclassify(doc, after, edit)
+
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		if node.Kind() == ast.KindBlockquote {
+			fmt.Println(types.Type(node))
+		}
+	}
+
+	// Output:
+	// tip
+	// warning
+}
+
+func ExampleBlockQuoteTypeMap_ReclassifyWithin() {
+	scope := admonitions.ClassifyLeadingWord
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithClassificationScope(scope))),
+	)
+
+	before := []byte("> Take note of this.\n\n> a quote\n")
+	pc := parser.NewContext()
+	markdown.Parser().Parse(text.NewReader(before), parser.WithContext(pc))
+	types := admonitions.BlockQuoteTypes(pc)
+
+	// The user appends to "a quote", the first quote is matched up again or,
+	// if touched, classified within the same scope
+	after := []byte("> Take note of this.\n\n> a quote, noted\n")
+	edit := admonitions.Edit{Start: 31, Stop: 31, NewLength: len(", noted")}
+	doc := markdown.Parser().Parse(text.NewReader(after))
+	types = types.ReclassifyWithin(doc, after, edit, scope)
+
+	for node := doc.FirstChild(); node != nil; node = node.NextSibling() {
+		fmt.Println(types.Type(node))
+	}
+
+	// Output:
+	// none
+	// none
+}
@@ -1,7 +1,6 @@
 package admonitions_test
 
 import (
-	"math/rand"
 	"os"
 
 	admonitions "github.com/PGlesmann/goldmark-admonitions"
@@ -47,11 +46,6 @@ And this isn't.
 }
 
 func Example_nested_indented() {
-	// one of the admonitions isn't closed correctly and so keeps the random
-	// admonition id. The seed keeps this repeatable, independently of the number
-	// of tests.
-	rand.Seed(1)
-
 	src := []byte(`
 ## Hello
 
@@ -96,7 +90,7 @@ And this isn't.
 	//   </div>
 	// </div>
 	// <p>this is level 1 again</p>
-	// <div class="admonition adm-note" data-admonition="xPLDnJObCsNVlgTeMaPEZQle">
+	// <div class="admonition adm-note" data-admonition="1">
 	//   <div class="adm-title">This is another note</div>
 	//   <div class="adm-body">
 	// <p>in level 2</p>
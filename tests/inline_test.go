@@ -0,0 +1,36 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithInlineAdmonitions() {
+	src := []byte(`Edit the file [!tip: remember to save] and reload, [!Warning: it restarts].
+
+[!note: a link](https://example.com) stays a link.
+`)
+
+	for _, target := range []admonitions.Target{admonitions.TargetHTML, admonitions.TargetConfluence} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(
+					admonitions.WithInlineAdmonitions(),
+					admonitions.WithTarget(target),
+				),
+			),
+		)
+
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <p>Edit the file <span class="admonition-inline adm-tip">remember to save</span> and reload, <span class="admonition-inline adm-warning">it restarts</span>.</p>
+	// <p><a href="https://example.com">!note: a link</a> stays a link.</p>
+	// <p>Edit the file <ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">Green</ac:parameter><ac:parameter ac:name="title">remember to save</ac:parameter></ac:structured-macro> and reload, <ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">Red</ac:parameter><ac:parameter ac:name="title">it restarts</ac:parameter></ac:structured-macro>.</p>
+	// <p><a href="https://example.com">!note: a link</a> stays a link.</p>
+}
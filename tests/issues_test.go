@@ -0,0 +1,58 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleIssues() {
+	src := []byte(`# Install
+
+!!!warning Flaky on Windows {track=issue owner=ci}
+The installer times out *sometimes*.
+
+- retry once
+- then report
+!!!
+
+!!!note Not tracked
+!!!
+
+> [!CAUTION] Missing docs {track=issue}
+> The flags aren't documented
+> > yet.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	_ = admonitions.WriteIssues(os.Stdout, admonitions.Issues("install.md", doc, src, "track", "issue"))
+
+	// Output:
+	// [
+	//   {
+	//     "file": "install.md",
+	//     "line": 3,
+	//     "type": "warning",
+	//     "title": "Flaky on Windows",
+	//     "body": "The installer times out *sometimes*.\n\n- retry once\n- then report",
+	//     "attributes": {
+	//       "owner": "ci"
+	//     }
+	//   },
+	//   {
+	//     "file": "install.md",
+	//     "line": 13,
+	//     "type": "caution",
+	//     "title": "Missing docs",
+	//     "body": "The flags aren't documented\n> yet."
+	//   }
+	// ]
+}
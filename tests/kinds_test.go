@@ -0,0 +1,102 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithKinds() {
+	src := []byte(`
+!!!security Rotate keys
+Every 90 days.
+!!!
+
+!!!legal Export rules
+Ask compliance.
+!!!
+`)
+
+	kinds := map[string]admonitions.Kind{
+		"security": {Inherits: "warning"},
+		"legal":    {Inherits: "security", ConfluenceMacro: "note"},
+	}
+	icons := map[string]admonitions.Icon{
+		"warning": {ViewBox: "0 0 24 24", Content: `<path d="M12 2 1 21h22z"/>`},
+	}
+
+	for _, target := range []admonitions.Target{admonitions.TargetHTML, admonitions.TargetConfluence} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(
+					admonitions.WithKinds(kinds),
+					admonitions.WithIcons(icons),
+					admonitions.WithIconSprite(),
+					admonitions.WithTarget(target),
+				),
+			),
+		)
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <div class="admonition adm-security adm-warning" data-admonition="0">
+	//   <div class="adm-title"><svg xmlns="http://www.w3.org/2000/svg" fill="currentColor" style="display: none"><symbol id="adm-icon-warning" viewBox="0 0 24 24"><path d="M12 2 1 21h22z"/></symbol></svg><svg class="adm-icon" aria-hidden="true"><use href="#adm-icon-warning"></use></svg>Rotate keys</div>
+	//   <div class="adm-body">
+	// <p>Every 90 days.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-legal adm-security adm-warning" data-admonition="0">
+	//   <div class="adm-title"><svg class="adm-icon" aria-hidden="true"><use href="#adm-icon-warning"></use></svg>Export rules</div>
+	//   <div class="adm-body">
+	// <p>Ask compliance.</p>
+	//   </div>
+	// </div>
+	// <ac:structured-macro ac:name="warning"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Rotate keys</ac:parameter><ac:rich-text-body>
+	// <p>Every 90 days.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Export rules</ac:parameter><ac:rich-text-body>
+	// <p>Ask compliance.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
+
+func ExampleWithTypeAliases() {
+	src := []byte(`
+!!!hint Shortcut {.wide}
+Press F1.
+!!!
+
+> [!ATTENTION]
+> Hot surface.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTypeAliases(map[string]string{"hint": "tip", "attention": "warning"}),
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithCustomAlerts(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip wide" data-admonition="0">
+	//   <div class="adm-title">Shortcut</div>
+	//   <div class="adm-body">
+	// <p>Press F1.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Hot surface.</p>
+	//   </div>
+	// </div>
+}
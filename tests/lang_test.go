@@ -0,0 +1,41 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_lang() {
+	src := []byte(`
+!!!note Remarque {lang=fr}
+Ceci est une note.
+!!!
+`)
+
+	for _, target := range []admonitions.Target{admonitions.TargetHTML, admonitions.TargetConfluence} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(admonitions.WithTarget(target)),
+			),
+		)
+
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <div lang="fr" class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Remarque</div>
+	//   <div class="adm-body">
+	// <p>Ceci est une note.</p>
+	//   </div>
+	// </div>
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Remarque</ac:parameter><ac:rich-text-body>
+	// <div lang="fr">
+	// <p>Ceci est une note.</p>
+	// </div>
+	// </ac:rich-text-body></ac:structured-macro>
+}
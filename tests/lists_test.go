@@ -0,0 +1,79 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_listItems() {
+	src := []byte(`
+## v2.0.0
+
+- Dropped Go 1.18.
+
+  !!!warning Breaking
+  Upgrade Go first.
+  !!!
+- Faster rendering.
+  > [!NOTE]
+  > Measured on large documents.
+- Fixed nested lists.
+  - With admonitions
+    !!!tip Indented
+      at any depth,
+    continued lazily.
+
+    Still in the item.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <h2>v2.0.0</h2>
+	// <ul>
+	// <li>
+	// <p>Dropped Go 1.18.</p>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Breaking</div>
+	//   <div class="adm-body">
+	// <p>Upgrade Go first.</p>
+	//   </div>
+	// </div>
+	// </li>
+	// <li>
+	// <p>Faster rendering.</p>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Measured on large documents.</p>
+	//   </div>
+	// </div>
+	// </li>
+	// <li>
+	// <p>Fixed nested lists.</p>
+	// <ul>
+	// <li>
+	// <p>With admonitions</p>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title">Indented</div>
+	//   <div class="adm-body">
+	// <p>at any depth,
+	// continued lazily.</p>
+	//   </div>
+	// </div>
+	// <p>Still in the item.</p>
+	// </li>
+	// </ul>
+	// </li>
+	// </ul>
+}
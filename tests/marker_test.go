@@ -0,0 +1,57 @@
+package admonitions_test
+
+import (
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleAdmonition_Marker() {
+	src := []byte(`!!!note Native
+!!!
+
+> [!WARNING] Alert
+
+> **Tip:** A bold label.
+
+> Info: a keyword.
+
+!!! danger "MkDocs"
+    body
+
+::: {.caution}
+Pandoc
+:::
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithBoldLabels(admonitions.BoldLabelBody),
+				admonitions.WithMkDocs(),
+				admonitions.WithPandocDivs(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*admonitions.Admonition); ok && entering {
+			marker, segment := n.Marker()
+			fmt.Printf("%q at %d-%d\n", marker, segment.Start, segment.Stop)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	// Output:
+	// "!!!note" at 0-7
+	// "[!WARNING]" at 22-32
+	// "**Tip:**" at 42-50
+	// "Info" at 68-72
+	// "!!! danger" at 86-96
+	// "::: {.caution}" at 116-130
+}
@@ -0,0 +1,53 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithMarkers() {
+	src := []byte(`
+!!!note Fenced
+Still parsed.
+!!!
+
+:::tip Directive
+Left to other extensions.
+:::
+
+> [!WARNING]
+> Converted without WithBlockQuoteAdmonitions.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithDirectives(),
+				admonitions.WithMarkers("!!!", ">"),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Fenced</div>
+	//   <div class="adm-body">
+	// <p>Still parsed.</p>
+	//   </div>
+	// </div>
+	// <p>:::tip Directive
+	// Left to other extensions.
+	// :::</p>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Converted without WithBlockQuoteAdmonitions.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,125 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// matrixContents are the bodies every target has to cope with, each with a
+// snippet that has to survive rendering
+var matrixContents = []struct {
+	name, body, want string
+}{
+	{"table", "| a | b |\n|---|---|\n| 1 | 2 |\n", "<td>1</td>"},
+	{"code", "```go\nfmt.Println(\"<hi>\")\n```\n", "fmt.Println(&quot;&lt;hi&gt;&quot;)"},
+	{"image", "![alt](img.png)\n", `src="img.png"`},
+	{"nested", "!!!tip Inner\ninner body\n!!!\n", "inner body"},
+	{"footnote", "See this[^1].\n\n[^1]: The note.\n", `href="#fn:1"`},
+	{"raw HTML", "<b>bold</b>\n\n<div>block</div>\n", "bold"},
+}
+
+// matrixTargets are the ways admonitions are rendered, each with a snippet
+// marking the admonition and whether the output has to be well-formed XML
+var matrixTargets = []struct {
+	name    string
+	options []admonitions.Option
+	html    []renderer.Option
+	want    string
+	xml     bool
+}{
+	{"html", nil, nil, `class="admonition adm-warning"`, false},
+	{"xhtml", nil, []renderer.Option{html.WithXHTML()}, `class="admonition adm-warning"`, true},
+	{"unsafe", nil, []renderer.Option{html.WithUnsafe()}, `class="admonition adm-warning"`, false},
+	{"confluence", []admonitions.Option{admonitions.WithTarget(admonitions.TargetConfluence)}, nil, `ac:name="warning"`, true},
+	{"confluence page", []admonitions.Option{admonitions.WithTarget(admonitions.TargetConfluence), admonitions.WithConfluenceOutput(admonitions.ConfluencePage)}, nil, `ac:name="warning"`, true},
+	{"web component", []admonitions.Option{admonitions.WithTarget(admonitions.TargetWebComponent)}, nil, `<doc-admonition type="warning"`, false},
+	{"responsive", []admonitions.Option{admonitions.WithResponsive()}, nil, `<details class="adm-details">`, false},
+	{"collapsible", nil, nil, `<details class="admonition adm-warning"`, false},
+	{"word limit", []admonitions.Option{admonitions.WithWordLimit(1)}, nil, `class="admonition adm-warning"`, false},
+	{"unwrap", []admonitions.Option{admonitions.WithUnwrap(true)}, nil, "", false},
+}
+
+func TestDegradationMatrix(t *testing.T) {
+	for _, target := range matrixTargets {
+		for _, content := range matrixContents {
+			target, content := target, content
+			t.Run(target.name+"/"+content.name, func(t *testing.T) {
+				opener := "!!!!warning Outer\n"
+				if target.name == "collapsible" {
+					opener = "!!!!warning Outer {collapsible=closed}\n"
+				}
+				src := []byte(opener + content.body + "!!!!\n")
+
+				markdown := goldmark.New(
+					goldmark.WithExtensions(extension.GFM, extension.Footnote, admonitions.New(target.options...)),
+					goldmark.WithRendererOptions(target.html...),
+				)
+
+				var buf bytes.Buffer
+				if err := markdown.Convert(src, &buf); err != nil {
+					t.Fatalf("Convert failed: %v", err)
+				}
+				out := buf.String()
+
+				if !strings.Contains(out, target.want) {
+					t.Errorf("the admonition is missing, want %q in:\n%s", target.want, out)
+				}
+				if !strings.Contains(out, content.want) {
+					t.Errorf("the body is missing, want %q in:\n%s", content.want, out)
+				}
+				if strings.Contains(out, "!!!") {
+					t.Errorf("markers leaked into the output:\n%s", out)
+				}
+				if err := checkMarkup(out, target.xml); err != nil {
+					t.Errorf("%v in:\n%s", err, out)
+				}
+			})
+		}
+	}
+}
+
+// checkMarkup returns an error if the elements of out aren't balanced, which
+// strict requires without HTML's void elements, or if an id is repeated
+func checkMarkup(out string, strict bool) error {
+	out = strings.TrimPrefix(out, "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	decoder := xml.NewDecoder(strings.NewReader("<root>" + out + "</root>"))
+	if !strict {
+		decoder.Strict = false
+		decoder.AutoClose = xml.HTMLAutoClose
+		decoder.Entity = xml.HTMLEntity
+	}
+
+	ids := map[string]bool{}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		for _, attr := range element.Attr {
+			if attr.Name.Local != "id" || attr.Name.Space != "" {
+				continue
+			}
+			if ids[attr.Value] {
+				return fmt.Errorf("duplicate id %q", attr.Value)
+			}
+			ids[attr.Value] = true
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithMetadata() {
+	src := []byte(`
+!!!warning </script> is escaped {#escaped}
+Body
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithMetadata()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div id="escaped" class="admonition adm-warning" data-admonition="0">
+	//   <script type="application/json" class="adm-metadata">{"type":"warning","title":"\u003c/script\u003e is escaped","id":"escaped"}</script>
+	//   <div class="adm-title">&lt;/script&gt; is escaped</div>
+	//   <div class="adm-body">
+	// <p>Body</p>
+	//   </div>
+	// </div>
+}
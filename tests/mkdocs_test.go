@@ -0,0 +1,204 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_mkDocs() {
+	src := []byte(`
+!!! warning "Don't touch"
+    The body is *indented*.
+
+    It can have several paragraphs.
+
+    !!! tip
+        And nested admonitions.
+
+!!! note ""
+    Without a title.
+
+!!! danger highlight
+    With another class.
+
+This is no longer in an admonition.
+
+!!!note Native syntax
+still works
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithMkDocs()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Don't touch</div>
+	//   <div class="adm-body">
+	// <p>The body is <em>indented</em>.</p>
+	// <p>It can have several paragraphs.</p>
+	// <div class="admonition adm-tip" data-admonition="1">
+	//   <div class="adm-title">Tip</div>
+	//   <div class="adm-body">
+	// <p>And nested admonitions.</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Without a title.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-danger highlight" data-admonition="0">
+	//   <div class="adm-title">Danger</div>
+	//   <div class="adm-body">
+	// <p>With another class.</p>
+	//   </div>
+	// </div>
+	// <p>This is no longer in an admonition.</p>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Native syntax</div>
+	//   <div class="adm-body">
+	// <p>still works</p>
+	//   </div>
+	// </div>
+}
+
+func Example_mkDocsCollapsible() {
+	src := []byte(`
+??? note "Details"
+    Collapsed by default.
+
+???+ tip
+    Expanded by default.
+
+!!!warning Attribute {collapsible=closed}
+Works for every syntax.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithMkDocs()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <details class="admonition adm-note" data-admonition="0">
+	//   <summary class="adm-title">Details</summary>
+	//   <div class="adm-body">
+	// <p>Collapsed by default.</p>
+	//   </div>
+	// </details>
+	// <details class="admonition adm-tip" data-admonition="0" open>
+	//   <summary class="adm-title">Tip</summary>
+	//   <div class="adm-body">
+	// <p>Expanded by default.</p>
+	//   </div>
+	// </details>
+	// <details class="admonition adm-warning" data-admonition="0">
+	//   <summary class="adm-title">Attribute</summary>
+	//   <div class="adm-body">
+	// <p>Works for every syntax.</p>
+	//   </div>
+	// </details>
+}
+
+func Example_mkDocsCollapsibleConfluence() {
+	src := []byte(`
+??? note "Details"
+    Collapsed by default.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithMkDocs(), admonitions.WithTarget(admonitions.TargetConfluence)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <ac:structured-macro ac:name="expand"><ac:parameter ac:name="title">Details</ac:parameter><ac:rich-text-body>
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Details</ac:parameter><ac:rich-text-body>
+	// <p>Collapsed by default.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// </ac:rich-text-body></ac:structured-macro>
+}
+
+func Example_mkDocsBlankLines() {
+	src := []byte(`!!! tip "Several paragraphs"
+    The first one.
+
+
+    The second one, after two blank lines.
+
+Not indented, so not part of the tip.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithMkDocs(), admonitions.WithSourceMap()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0" data-source-lines="1-5">
+	//   <div class="adm-title">Several paragraphs</div>
+	//   <div class="adm-body">
+	// <p>The first one.</p>
+	// <p>The second one, after two blank lines.</p>
+	//   </div>
+	// </div>
+	// <p>Not indented, so not part of the tip.</p>
+}
+
+func Example_collapseParameter() {
+	src := []byte(`
+!!!note Macro parameter {collapse=true}
+Not collapsible.
+!!!
+`)
+
+	for _, target := range []admonitions.Target{admonitions.TargetHTML, admonitions.TargetConfluence} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(
+					admonitions.WithTarget(target),
+					admonitions.WithConfluenceParameters(map[string]string{"collapse": "collapse"}),
+				),
+			),
+		)
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Macro parameter</div>
+	//   <div class="adm-body">
+	// <p>Not collapsible.</p>
+	//   </div>
+	// </div>
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Macro parameter</ac:parameter><ac:parameter ac:name="collapse">true</ac:parameter><ac:rich-text-body>
+	// <p>Not collapsible.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
@@ -0,0 +1,31 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"sort"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+)
+
+func ExampleParseNodeKind() {
+	fmt.Println(admonitions.KindAdmonition)
+
+	kind, ok := admonitions.ParseNodeKind("Admonition")
+	fmt.Println(kind == admonitions.KindAdmonition, ok)
+
+	_, ok = admonitions.ParseNodeKind("Paragraph")
+	fmt.Println(ok)
+
+	var names []string
+	for name := range admonitions.NodeKinds() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Println(names)
+
+	// Output:
+	// Admonition
+	// true true
+	// false
+	// [Abbreviation Admonition InlineAdmonition Keys Problem Tab]
+}
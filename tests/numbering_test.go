@@ -0,0 +1,77 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+func ExampleWithNumbering() {
+	src := []byte(`# Installing
+
+!!!warning Careful
+Back up first.
+!!!
+
+!!!warning
+Really.
+!!!
+
+# Upgrading
+
+## From 1.x
+
+!!!note
+Read the changelog.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithNumbering(1))),
+	)
+	if err := markdown.Convert(src, os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// <h1>Installing</h1>
+	// <div class="admonition adm-warning" data-admonition="0" data-number="1.1">
+	//   <div class="adm-title">Warning 1.1: Careful</div>
+	//   <div class="adm-body">
+	// <p>Back up first.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0" data-number="1.2">
+	//   <div class="adm-title">Warning 1.2</div>
+	//   <div class="adm-body">
+	// <p>Really.</p>
+	//   </div>
+	// </div>
+	// <h1>Upgrading</h1>
+	// <h2>From 1.x</h2>
+	// <div class="admonition adm-note" data-admonition="0" data-number="2.1">
+	//   <div class="adm-title">Note 2.1</div>
+	//   <div class="adm-body">
+	// <p>Read the changelog.</p>
+	//   </div>
+	// </div>
+}
+
+func TestNumberingNegativeSectionLevel(t *testing.T) {
+	src := []byte("# Installing\n\n!!!warning\nBack up first.\n!!!\n")
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithNumbering(-1))),
+	)
+	var buf bytes.Buffer
+	if err := markdown.Convert(src, &buf); err != nil {
+		t.Fatalf("Convert failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), ">Warning 1</div>") {
+		t.Errorf("not numbered without sections:\n%s", buf.String())
+	}
+}
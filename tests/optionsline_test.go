@@ -0,0 +1,67 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithOptionsLine() {
+	src := []byte(`
+!!!tip Launch
+icon=rocket open=false color="rgb(255 140 0)"
+Ready for takeoff.
+!!!
+
+!!!note Not options
+This isn't key=value only.
+!!!
+
+!!!warning Not a color
+color="red; background: url(x)"
+Unstyled.
+!!!
+`)
+
+	icons := map[string]admonitions.Icon{
+		"rocket": {ViewBox: "0 0 24 24", Content: `<path d="M12 2l4 8h-8z"/>`},
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithOptionsLine(),
+				admonitions.WithIcons(icons),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	first := doc.FirstChild().(*admonitions.Admonition)
+	fmt.Println(first.Options["icon"], first.Options["open"], first.Options["color"])
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// rocket false rgb(255 140 0)
+	// <details class="admonition adm-tip" data-admonition="0" style="--adm-color: rgb(255 140 0)">
+	//   <summary class="adm-title"><svg class="adm-icon" aria-hidden="true" xmlns="http://www.w3.org/2000/svg" fill="currentColor" viewBox="0 0 24 24"><path d="M12 2l4 8h-8z"/></svg>Launch</summary>
+	//   <div class="adm-body">
+	// <p>Ready for takeoff.</p>
+	//   </div>
+	// </details>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Not options</div>
+	//   <div class="adm-body">
+	// <p>This isn't key=value only.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Not a color</div>
+	//   <div class="adm-body">
+	// <p>Unstyled.</p>
+	//   </div>
+	// </div>
+}
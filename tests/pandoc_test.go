@@ -0,0 +1,68 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_pandocDivs() {
+	src := []byte(`
+::: {.warning #careful title="Careful"}
+The body is *Markdown*.
+
+::: {.columns}
+Other divs are kept as text.
+:::
+
+::: tip
+Nested admonitions work.
+:::
+:::
+
+::::: {.danger .big}
+No title.
+:::
+
+::: {.sidebar}
+Not an admonition.
+:::
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithPandocDivs()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div id="careful" class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Careful</div>
+	//   <div class="adm-body">
+	// <p>The body is <em>Markdown</em>.</p>
+	// <p>::: {.columns}
+	// Other divs are kept as text.
+	// :::</p>
+	// <div class="admonition adm-tip" data-admonition="1">
+	//   <div class="adm-title">Tip</div>
+	//   <div class="adm-body">
+	// <p>Nested admonitions work.</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-danger big" data-admonition="0">
+	//   <div class="adm-title">Danger</div>
+	//   <div class="adm-body">
+	// <p>No title.</p>
+	//   </div>
+	// </div>
+	// <p>::: {.sidebar}
+	// Not an admonition.
+	// :::</p>
+}
@@ -0,0 +1,55 @@
+package admonitions_test
+
+import (
+	"os"
+	"regexp"
+	"time"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+// Example_manyTransformers enables enough options and extensions for goldmark
+// to sort more than a dozen AST transformers, which it doesn't do stably
+func Example_manyTransformers() {
+	src := []byte(`> [!NOTE]
+> Body {{v}} HTML JIRA-2
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			extension.Footnote,
+			extension.Typographer,
+			extension.DefinitionList,
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithVars(map[string]string{"v": "1"}),
+				admonitions.WithAbbreviations(map[string]string{"HTML": "HyperText Markup Language"}),
+				admonitions.WithTicketLinks(admonitions.TicketLink{Pattern: regexp.MustCompile(`\bJIRA-\d+\b`), URL: "https://jira.example.com/browse/$0"}),
+				admonitions.WithNumbering(0),
+				admonitions.WithRestrictions(admonitions.Restrictions{MaxImages: -1}),
+				admonitions.WithKinds(map[string]admonitions.Kind{"security": {Inherits: "warning"}}),
+				admonitions.WithContainers(nil, ast.KindDocument),
+				admonitions.WithOptionsLine(),
+				admonitions.WithExpiry(admonitions.ExpiryHide),
+				admonitions.WithClock(func() time.Time { return time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC) }),
+				admonitions.WithTerminators(admonitions.Terminators{Unterminated: admonitions.UnterminatedError}),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0" data-number="1">
+	//   <div class="adm-title">Note 1</div>
+	//   <div class="adm-body">
+	// <p>Body 1 <abbr title="HyperText Markup Language">HTML</abbr> <a href="https://jira.example.com/browse/JIRA-2">JIRA-2</a></p>
+	//   </div>
+	// </div>
+}
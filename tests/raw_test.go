@@ -0,0 +1,57 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_raw() {
+	src := []byte(`
+!!!raw
+<ac:structured-macro ac:name="toc" />
+  *not* markdown
+!!!
+
+after
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTarget(admonitions.TargetConfluence),
+				admonitions.WithUnsafe(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <ac:structured-macro ac:name="toc" />
+	//   *not* markdown
+	// <p>after</p>
+}
+
+func Example_rawOmitted() {
+	src := []byte(`
+!!!raw
+<script>alert(1)</script>
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <!-- raw HTML omitted -->
+}
@@ -0,0 +1,55 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// arrowWriter renders "->" as an arrow, in bodies and titles alike
+type arrowWriter struct {
+	html.Writer
+}
+
+func (a arrowWriter) Write(w util.BufWriter, source []byte) {
+	a.Writer.Write(w, bytes.ReplaceAll(source, []byte("->"), []byte("→")))
+}
+
+func Example_rendererOptions() {
+	src := []byte(`
+!!!note Build -> Deploy
+Run make -> <kbd>push</kbd>.
+!!!
+
+!!!raw
+<hr class="raw">
+!!!
+`)
+
+	// html.WithUnsafe writes raw HTML in bodies, raw admonitions take
+	// admonitions.WithUnsafe
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New()),
+		goldmark.WithRendererOptions(
+			html.WithUnsafe(),
+			html.WithWriter(arrowWriter{html.DefaultWriter}),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Build → Deploy</div>
+	//   <div class="adm-body">
+	// <p>Run make → <kbd>push</kbd>.</p>
+	//   </div>
+	// </div>
+	// <!-- raw HTML omitted -->
+}
@@ -0,0 +1,133 @@
+package admonitions_test
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_reportTree() {
+	fsys := fstest.MapFS{
+		"index.md":       {Data: []byte("!!!note A\n!!!\n\n!!!danger B\n!!!\n\n> [!TIP]\n> a tip\n")},
+		"guide/setup.md": {Data: []byte("!!!danger C\n!!!\n\n!!!danger D\n!!!\n")},
+		"notes.txt":      {Data: []byte("!!!note not markdown\n!!!\n")},
+	}
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	report, _ := admonitions.ReportTree(fsys, markdown)
+	_ = report.WriteCSV(os.Stdout)
+
+	// Output:
+	// path,type,count
+	// guide/setup.md,danger,2
+	// index.md,danger,1
+	// index.md,note,1
+	// index.md,tip,1
+}
+
+func Example_mergeReports() {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			&admonitions.Extender{},
+		),
+	)
+
+	files := []struct{ path, src string }{
+		{"index.md", "!!!note Setup\nRun the installer.\n!!!\n"},
+		{"guide.md", "# Guide\n\n!!!!danger Careful\nDon't.\n\n!!!tip\nA nested tip.\n!!!\n!!!!\n"},
+	}
+
+	var reports []*admonitions.Report
+	for _, file := range files {
+		src := []byte(file.src)
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		reports = append(reports, admonitions.NewReport(file.path, doc, src, nil))
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(admonitions.MergeReports(reports...))
+
+	// Output:
+	// {
+	//   "files": [
+	//     "index.md",
+	//     "guide.md"
+	//   ],
+	//   "counts": {
+	//     "danger": 1,
+	//     "note": 1,
+	//     "tip": 1
+	//   },
+	//   "words": 7,
+	//   "admonitions": [
+	//     {
+	//       "file": "index.md",
+	//       "type": "note",
+	//       "title": "Setup",
+	//       "startLine": 1,
+	//       "endLine": 3,
+	//       "words": 3
+	//     },
+	//     {
+	//       "file": "guide.md",
+	//       "type": "danger",
+	//       "title": "Careful",
+	//       "startLine": 3,
+	//       "endLine": 9,
+	//       "words": 4
+	//     },
+	//     {
+	//       "file": "guide.md",
+	//       "type": "tip",
+	//       "startLine": 6,
+	//       "endLine": 8,
+	//       "words": 3
+	//     }
+	//   ]
+	// }
+}
+
+func TestReportsCountAlike(t *testing.T) {
+	// an admonition, a converted alert and a classified blockquote which
+	// isn't converted, as its keyword isn't leading
+	src := []byte("!!!note A\n!!!\n\n> [!TIP]\n> a tip\n\n> Be careful, this is a warning.\n")
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	tree, err := admonitions.ReportTree(fstest.MapFS{"index.md": {Data: src}}, markdown)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pc := parser.NewContext()
+	doc := markdown.Parser().Parse(text.NewReader(src), parser.WithContext(pc))
+	report := admonitions.NewReport("index.md", doc, src, admonitions.BlockQuoteTypes(pc))
+
+	want := map[string]int{"note": 1, "tip": 1, "warning": 1}
+	if !reflect.DeepEqual(tree.Totals, want) {
+		t.Errorf("ReportTree: got %v, want %v", tree.Totals, want)
+	}
+	if !reflect.DeepEqual(report.Counts, want) {
+		t.Errorf("NewReport: got %v, want %v", report.Counts, want)
+	}
+	if last := report.Admonitions[len(report.Admonitions)-1]; last.StartLine != 7 || last.EndLine != 7 || last.Words != 6 {
+		t.Errorf("NewReport: got %+v for the blockquote", last)
+	}
+}
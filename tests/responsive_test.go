@@ -0,0 +1,158 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_responsive() {
+	src := []byte(`
+!!!note A long note
+The *body*
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithResponsive()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0" data-adm-key="note-a-long-note">
+	//   <div class="adm-open">
+	//   <div class="adm-title">A long note</div>
+	//   <div class="adm-body">
+	// <p>The <em>body</em></p>
+	//   </div>
+	//   </div>
+	//   <details class="adm-details">
+	//   <summary class="adm-title">A long note</summary>
+	//   <div class="adm-body">
+	// <p>The <em>body</em></p>
+	//   </div>
+	//   </details>
+	// </div>
+}
+
+func Example_responsiveTabs() {
+	src := []byte(`
+!!!tip Printing
+=== "Go"
+
+    ~~~go
+    fmt.Println("hi")
+    ~~~
+
+See [the top](#top).
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithResponsive(), admonitions.WithContentTabs()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0" data-adm-key="tip-printing">
+	//   <div class="adm-open">
+	//   <div class="adm-title">Printing</div>
+	//   <div class="adm-body">
+	// <div class="tabbed-set" data-tabs="1:1"><input checked="checked" id="__tabbed_1_1" name="__tabbed_1" type="radio"><label for="__tabbed_1_1">Go</label>
+	// <div class="tabbed-content">
+	// <pre><code class="language-go">fmt.Println(&quot;hi&quot;)
+	// </code></pre>
+	// </div>
+	// </div>
+	// <p>See <a href="#top">the top</a>.</p>
+	//   </div>
+	//   </div>
+	//   <details class="adm-details">
+	//   <summary class="adm-title">Printing</summary>
+	//   <div class="adm-body">
+	// <div class="tabbed-set" data-tabs="1:1"><input checked="checked" id="__tabbed_1_1-details" name="__tabbed_1" type="radio"><label for="__tabbed_1_1-details">Go</label>
+	// <div class="tabbed-content">
+	// <pre><code class="language-go">fmt.Println(&quot;hi&quot;)
+	// </code></pre>
+	// </div>
+	// </div>
+	// <p>See <a href="#top">the top</a>.</p>
+	//   </div>
+	//   </details>
+	// </div>
+}
+
+func ExampleWithCollapseScript() {
+	src := []byte(`
+!!!tip Same title
+One
+!!!
+
+!!!tip Same title
+Two
+!!!
+
+!!!tip {#by-id}
+Three
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithResponsive(), admonitions.WithCollapseScript()),
+		),
+	)
+
+	var buf bytes.Buffer
+	_ = markdown.Convert(src, &buf)
+	fmt.Println(strings.Count(buf.String(), admonitions.CollapseScript))
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.HasPrefix(line, "<div") {
+			fmt.Println(line)
+		}
+	}
+
+	// Output:
+	// 1
+	// <div class="admonition adm-tip" data-admonition="0" data-adm-key="tip-same-title">
+	// <div class="admonition adm-tip" data-admonition="0" data-adm-key="tip-same-title-2">
+	// <div id="by-id" class="admonition adm-tip" data-admonition="0" data-adm-key="by-id">
+}
+
+func ExampleWithCollapseScript_collapsible() {
+	src := []byte(`
+!!!tip Folded {collapsible=closed}
+Remembered once opened.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithCollapseScript()),
+		),
+	)
+
+	var buf bytes.Buffer
+	_ = markdown.Convert(src, &buf)
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.HasPrefix(line, "<details") {
+			fmt.Println(line)
+		}
+	}
+
+	// Output:
+	// <details class="admonition adm-tip" data-admonition="0" data-adm-key="tip-folded">
+}
@@ -0,0 +1,43 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+func ExampleWithRestrictions() {
+	src := []byte(`<b>trusted</b>
+
+!!!note Posted by a user {onclick="steal()"}
+<b>bold</b> [docs](https://example.com), [click](javascript:alert(1)) and [relative](/faq)
+
+![one](https://example.com/1.png) ![two](https://example.com/2.png)
+
+<div>block</div>
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithRestrictions(admonitions.Restrictions{MaxImages: 1}),
+		)),
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+	if err := markdown.Convert(src, os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// <p><b>trusted</b></p>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Posted by a user</div>
+	//   <div class="adm-body">
+	// <p><!-- raw HTML omitted -->bold<!-- raw HTML omitted --> <a href="https://example.com">docs</a>, click and <a href="/faq">relative</a></p>
+	// <p><img src="https://example.com/1.png" alt="one"> two</p>
+	// <!-- raw HTML omitted -->
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,53 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithReStructuredText() {
+	src := []byte(`
+.. warning:: Don't run this in production.
+
+   More *details*.
+
+.. admonition:: Migration status
+   :class: tip wide
+   :name: status
+
+   Half of the pages are done.
+
+.. code-block:: go
+
+Not indented, not in the admonition.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithReStructuredText()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Warning</div>
+	//   <div class="adm-body">
+	// <p>Don't run this in production.</p>
+	// <p>More <em>details</em>.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip wide" data-admonition="0" id="status">
+	//   <div class="adm-title">Migration status</div>
+	//   <div class="adm-body">
+	// <p>Half of the pages are done.</p>
+	//   </div>
+	// </div>
+	// <p>.. code-block:: go</p>
+	// <p>Not indented, not in the admonition.</p>
+}
@@ -0,0 +1,49 @@
+package admonitions_test
+
+import (
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithClassScope() {
+	src := []byte(`
+!!!note Scoped {.custom}
+Styled per document.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithClassScope("docs"),
+				admonitions.WithResponsive(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	fmt.Println(admonitions.ScopeHash("docs"))
+
+	// Output:
+	// <div class="admonition-docs adm-note-docs custom" data-admonition="0" data-adm-key="note-scoped">
+	//   <div class="adm-open-docs">
+	//   <div class="adm-title-docs">Scoped</div>
+	//   <div class="adm-body-docs">
+	// <p>Styled per document.</p>
+	//   </div>
+	//   </div>
+	//   <details class="adm-details-docs">
+	//   <summary class="adm-title-docs">Scoped</summary>
+	//   <div class="adm-body-docs">
+	// <p>Styled per document.</p>
+	//   </div>
+	//   </details>
+	// </div>
+	// 46b42b42
+}
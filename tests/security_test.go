@@ -0,0 +1,35 @@
+package admonitions_test
+
+import (
+	"fmt"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleFindExternalLinks() {
+	src := []byte(`
+See [the docs](https://example.com/elsewhere), outside of admonitions.
+
+!!!warning Update now
+Download the patch from [our site](https://downloads.example.com/patch),
+not from [a mirror](http://mirror.example.net/patch) or https://evil.example.org/.
+See also [the changelog](/changelog).
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(&admonitions.Extender{}, extension.Linkify),
+	)
+	doc := markdown.Parser().Parse(text.NewReader(src))
+
+	for _, link := range admonitions.FindExternalLinks(doc, src, "example.com") {
+		fmt.Printf("%s: %s\n", link.Admonition.Title, link.Host)
+	}
+
+	// Output:
+	// Update now: mirror.example.net
+	// Update now: evil.example.org
+}
@@ -0,0 +1,74 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleMarshalAdmonition() {
+	src := []byte(`!!!warning Before upgrading {#upgrade}
+Back up **everything**, see [the guide](https://example.com/backup).
+
+| Version | Supported |
+|---------|:---------:|
+| 1.x     | no        |
+
+- [x] Stop the server
+- [ ] Run ` + "`migrate`" + `
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(extension.GFM, admonitions.New()),
+	)
+	doc := markdown.Parser().Parse(text.NewReader(src))
+
+	data, err := admonitions.MarshalAdmonition(doc.FirstChild().(*admonitions.Admonition), src)
+	if err != nil {
+		panic(err)
+	}
+
+	// e.g. in another process
+	n, source, err := admonitions.UnmarshalAdmonition(data)
+	if err != nil {
+		panic(err)
+	}
+	var original, decoded bytes.Buffer
+	markdown.Renderer().Render(&original, src, doc)
+	markdown.Renderer().Render(&decoded, source, n)
+	fmt.Println(bytes.Equal(original.Bytes(), decoded.Bytes()))
+	os.Stdout.Write(decoded.Bytes())
+
+	// Output:
+	// true
+	// <div id="upgrade" class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Before upgrading</div>
+	//   <div class="adm-body">
+	// <p>Back up <strong>everything</strong>, see <a href="https://example.com/backup">the guide</a>.</p>
+	// <table>
+	// <thead>
+	// <tr>
+	// <th>Version</th>
+	// <th style="text-align:center">Supported</th>
+	// </tr>
+	// </thead>
+	// <tbody>
+	// <tr>
+	// <td>1.x</td>
+	// <td style="text-align:center">no</td>
+	// </tr>
+	// </tbody>
+	// </table>
+	// <ul>
+	// <li><input checked="" disabled="" type="checkbox"> Stop the server</li>
+	// <li><input disabled="" type="checkbox"> Run <code>migrate</code></li>
+	// </ul>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,131 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_severity() {
+	src := []byte(`!!!2 warning Deprecated
+Removed in the next major release.
+!!!
+
+> [!WARNING:3]
+> Deletes all data.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+		)),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	fmt.Println(doc.FirstChild().(*admonitions.Admonition).Severity)
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// 2
+	// <div class="admonition adm-warning" data-admonition="0" data-severity="2">
+	//   <div class="adm-title">Deprecated</div>
+	//   <div class="adm-body">
+	// <p>Removed in the next major release.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0" data-severity="3">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Deletes all data.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_severityLongNumber() {
+	src := []byte(`!!!2024 Release notes
+Everything that changed this year.
+!!!
+`)
+
+	markdown := goldmark.New(goldmark.WithExtensions(admonitions.New()))
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	fmt.Println(doc.FirstChild().(*admonitions.Admonition).Severity)
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// 0
+	// <div class="admonition adm-2024" data-admonition="0">
+	//   <div class="adm-title">Release notes</div>
+	//   <div class="adm-body">
+	// <p>Everything that changed this year.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_severityAlertLongNumber() {
+	// not an alert marker, only the legacy keyword makes it a warning
+	src := []byte(`> [!WARNING:23]
+> Not a severity.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+		)),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>[!WARNING:23]
+	// Not a severity.</p>
+	//   </div>
+	// </div>
+}
+
+func ExampleWithSeverityMacros() {
+	src := []byte(`!!!1 warning
+Minor.
+!!!
+
+!!!2 warning
+Moderate.
+!!!
+
+!!!warning
+Ungraded.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithTarget(admonitions.TargetConfluence),
+			admonitions.WithSeverityMacros(map[int]string{1: "info", 2: "note", 3: "warning"}),
+		)),
+	)
+	var buf bytes.Buffer
+	if err := markdown.Convert(src, &buf); err != nil {
+		panic(err)
+	}
+	fmt.Print(buf.String())
+
+	// Output:
+	// <ac:structured-macro ac:name="info"><ac:parameter ac:name="icon">true</ac:parameter><ac:rich-text-body>
+	// <p>Minor.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <ac:structured-macro ac:name="note"><ac:parameter ac:name="icon">true</ac:parameter><ac:rich-text-body>
+	// <p>Moderate.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+	// <ac:structured-macro ac:name="warning"><ac:parameter ac:name="icon">true</ac:parameter><ac:rich-text-body>
+	// <p>Ungraded.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
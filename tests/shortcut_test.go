@@ -0,0 +1,44 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_shortcut() {
+	src := []byte(`
+!!!shortcut Zoom
+Press ` + "`Ctrl++`" + ` to zoom in and ` + "`Ctrl+-`" + ` to zoom out.
+!!!
+
+!!!note
+Code spans like ` + "`a+b`" + ` stay code elsewhere.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithIcons(admonitions.DefaultIcons)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-shortcut" data-admonition="0">
+	//   <div class="adm-title"><svg class="adm-icon" aria-hidden="true" xmlns="http://www.w3.org/2000/svg" fill="currentColor" viewBox="0 0 24 24"><rect x="2" y="6" width="20" height="12" rx="2" fill="none" stroke="currentColor" stroke-width="2"/><path d="M5 9h2v2H5zm4 0h2v2H9zm4 0h2v2h-2zm4 0h2v2h-2zM7 13h10v2H7z"/></svg>Zoom</div>
+	//   <div class="adm-body">
+	// <p>Press <kbd class="adm-keys"><kbd>Ctrl</kbd>+<kbd>+</kbd></kbd> to zoom in and <kbd class="adm-keys"><kbd>Ctrl</kbd>+<kbd>-</kbd></kbd> to zoom out.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"><svg class="adm-icon" aria-hidden="true" xmlns="http://www.w3.org/2000/svg" fill="currentColor" viewBox="0 0 24 24"><path d="M5 3h10l4 4v14H5z" fill="none" stroke="currentColor" stroke-width="2"/><path d="M8 11h8v2H8zm0 4h8v2H8z"/></svg></div>
+	//   <div class="adm-body">
+	// <p>Code spans like <code>a+b</code> stay code elsewhere.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,60 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_sourceMap() {
+	src := []byte(`# Title
+
+!!!note First
+Body
+
+!!!danger Nested
+Inner
+!!!
+!!!
+
+!!!tip Indented
+   closed by indentation
+
+The end.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithSourceMap()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+	_ = admonitions.WriteSourceMap(os.Stdout, doc, src)
+
+	// Output:
+	// <h1>Title</h1>
+	// <div class="admonition adm-note" data-admonition="0" data-source-lines="3-9">
+	//   <div class="adm-title">First</div>
+	//   <div class="adm-body">
+	// <p>Body</p>
+	// <div class="admonition adm-danger" data-admonition="1" data-source-lines="6-8">
+	//   <div class="adm-title">Nested</div>
+	//   <div class="adm-body">
+	// <p>Inner</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0" data-source-lines="11-12">
+	//   <div class="adm-title">Indented</div>
+	//   <div class="adm-body">
+	// <p>closed by indentation</p>
+	//   </div>
+	// </div>
+	// <p>The end.</p>
+	// [{"index":0,"class":"note","startLine":3,"endLine":9},{"index":1,"class":"danger","startLine":6,"endLine":8},{"index":2,"class":"tip","startLine":11,"endLine":12}]
+}
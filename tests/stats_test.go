@@ -0,0 +1,80 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+func ExampleWithStats() {
+	src := []byte(`!!!note Small
+Quick.
+!!!
+
+!!!!warning Outer
+!!!tip Inner
+Nested.
+!!!
+!!!!
+`)
+
+	// a clock advancing by 10ms whenever it is read, instead of time.Now
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		now = now.Add(10 * time.Millisecond)
+		return now
+	}
+
+	stats := admonitions.Stats{Now: clock}
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(
+			admonitions.WithStats(&stats),
+		)),
+	)
+	if err := markdown.Convert(src, &bytes.Buffer{}); err != nil {
+		panic(err)
+	}
+
+	for _, timing := range stats.Timings() {
+		fmt.Printf("line %d %s %q: %v\n", timing.Line, timing.Class, timing.Title, timing.Duration)
+	}
+	fmt.Println("total:", stats.Total())
+	fmt.Println("slowest:", stats.Slowest(1)[0].Title)
+	fmt.Println("slow:", len(stats.Slow(20*time.Millisecond)))
+
+	// Output:
+	// line 1 note "Small": 10ms
+	// line 6 tip "Inner": 10ms
+	// line 5 warning "Outer": 30ms
+	// total: 40ms
+	// slowest: Outer
+	// slow: 1
+}
+
+func TestStatsConcurrent(t *testing.T) {
+	var stats admonitions.Stats
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithStats(&stats))),
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := markdown.Convert([]byte("!!!note\nBody\n!!!\n"), &bytes.Buffer{}); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(stats.Timings()); got != 8 {
+		t.Errorf("got %d timings, want 8", got)
+	}
+}
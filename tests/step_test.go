@@ -0,0 +1,72 @@
+package admonitions_test
+
+import (
+	"os"
+	"regexp"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_stepAlerts() {
+	src := []byte(`
+> [!STEP 1]
+> Download the installer.
+
+> [!step 2] Run it
+> Run it.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote), admonitions.WithStepAlerts(nil)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-step" data-admonition="0" data-step="1">
+	//   <div class="adm-title">Step 1</div>
+	//   <div class="adm-body">
+	// <p>Download the installer.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-step" data-admonition="0" data-step="2">
+	//   <div class="adm-title">Step 2: Run it</div>
+	//   <div class="adm-body">
+	// <p>Run it.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_stepAlertsPattern() {
+	src := []byte(`
+> [!SCHRITT 4]
+> Neu starten.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithStepAlerts(regexp.MustCompile(`^SCHRITT (\d+)$`)),
+				admonitions.WithMetadata(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-step" data-admonition="0" data-step="4">
+	//   <script type="application/json" class="adm-metadata">{"type":"step","title":"Step 4","step":4}</script>
+	//   <div class="adm-title">Step 4</div>
+	//   <div class="adm-body">
+	// <p>Neu starten.</p>
+	//   </div>
+	// </div>
+}
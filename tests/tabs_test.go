@@ -0,0 +1,53 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+)
+
+func ExampleWithContentTabs() {
+	src := []byte(`!!!tip Printing
+=== "Go"
+
+    ~~~go
+    fmt.Println("hi")
+    ~~~
+
+===+ "Python"
+
+    ~~~python
+    print("hi")
+    ~~~
+
+Works in both.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(admonitions.New(admonitions.WithContentTabs())),
+	)
+	if err := markdown.Convert(src, os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title">Printing</div>
+	//   <div class="adm-body">
+	// <div class="tabbed-set" data-tabs="1:2"><input id="__tabbed_1_1" name="__tabbed_1" type="radio"><label for="__tabbed_1_1">Go</label>
+	// <div class="tabbed-content">
+	// <pre><code class="language-go">fmt.Println(&quot;hi&quot;)
+	// </code></pre>
+	// </div>
+	// <input checked="checked" id="__tabbed_1_2" name="__tabbed_1" type="radio"><label for="__tabbed_1_2">Python</label>
+	// <div class="tabbed-content">
+	// <pre><code class="language-python">print(&quot;hi&quot;)
+	// </code></pre>
+	// </div>
+	// </div>
+	// <p>Works in both.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,73 @@
+package admonitions_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithTerminators() {
+	src := []byte(`
+!!!note Outer
+!!!!
+This stray fence doesn't close the note.
+
+:::tip Directive
+Closed by the keyword.
+::: end
+!!! end
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithDirectives(),
+				admonitions.WithTerminators(admonitions.Terminators{ExactLength: true, EndKeyword: true}),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Outer</div>
+	//   <div class="adm-body">
+	// <p>!!!!
+	// This stray fence doesn't close the note.</p>
+	// <div class="admonition adm-tip" data-admonition="1">
+	//   <div class="adm-title">Directive</div>
+	//   <div class="adm-body">
+	// <p>Closed by the keyword.</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+}
+
+func ExampleWithTerminators_unterminated() {
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTerminators(admonitions.Terminators{Unterminated: admonitions.UnterminatedError}),
+			),
+		),
+	)
+
+	for _, src := range []string{
+		"!!!note Closed\nbody\n!!!\n",
+		"# Intro\n\n!!!warning Open\nbody\n",
+	} {
+		var buf bytes.Buffer
+		fmt.Println(markdown.Convert([]byte(src), &buf))
+	}
+
+	// Output:
+	// <nil>
+	// admonitions: line 3: the warning admonition isn't closed
+}
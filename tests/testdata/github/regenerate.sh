@@ -0,0 +1,22 @@
+#!/bin/sh
+# regenerate.sh renders every README of this directory with the GitHub
+# /markdown API in gfm mode and replaces the HTML next to it, e.g.
+#
+#	GITHUB_TOKEN=... ./regenerate.sh
+#
+# Provenance: the HTML files here were written by hand after the markup
+# GitHub renders for alerts (octicon paths and markdown-heading wrappers as
+# of 2024) and haven't been captured from the API yet. Run this script and
+# commit the result together with the date of the capture below.
+#
+# Captured: never
+set -eu
+cd "$(dirname "$0")"
+for source in *.md; do
+	jq -Rs '{text: ., mode: "gfm"}' "$source" |
+		curl -fsS -X POST \
+			-H "Accept: application/vnd.github+json" \
+			${GITHUB_TOKEN:+-H "Authorization: Bearer $GITHUB_TOKEN"} \
+			--data-binary @- https://api.github.com/markdown \
+			>"${source%.md}.html"
+done
@@ -0,0 +1,41 @@
+package admonitions_test
+
+import (
+	"os"
+	"regexp"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_ticketLinks() {
+	src := []byte(`
+!!!note Fixed in JIRA-123
+See #4567 and JIRA-7, but not ` + "`JIRA-8`" + ` or [JIRA-9](https://example.com).
+!!!
+
+Outside of admonitions JIRA-10 stays as it is.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithTicketLinks(
+				admonitions.TicketLink{Pattern: regexp.MustCompile(`\bJIRA-\d+\b`), URL: "https://jira.example.com/browse/$0"},
+				admonitions.TicketLink{Pattern: regexp.MustCompile(`#(\d+)\b`), URL: "https://github.com/org/repo/issues/$1"},
+			)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Fixed in <a href="https://jira.example.com/browse/JIRA-123">JIRA-123</a></div>
+	//   <div class="adm-body">
+	// <p>See <a href="https://github.com/org/repo/issues/4567">#4567</a> and <a href="https://jira.example.com/browse/JIRA-7">JIRA-7</a>, but not <code>JIRA-8</code> or <a href="https://example.com">JIRA-9</a>.</p>
+	//   </div>
+	// </div>
+	// <p>Outside of admonitions JIRA-10 stays as it is.</p>
+}
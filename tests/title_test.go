@@ -0,0 +1,176 @@
+package admonitions_test
+
+import (
+	"os"
+	"strings"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_titleHeading() {
+	src := []byte(`
+## Hello
+
+!!!note The title is a h3
+Outer
+!!!danger And this one a h4
+Nested
+!!!
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithTitleElement(admonitions.TitleHeading)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <h2>Hello</h2>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <h3 class="adm-title">The title is a h3</h3>
+	//   <div class="adm-body">
+	// <p>Outer</p>
+	// <div class="admonition adm-danger" data-admonition="1">
+	//   <h4 class="adm-title">And this one a h4</h4>
+	//   <div class="adm-body">
+	// <p>Nested</p>
+	//   </div>
+	// </div>
+	//   </div>
+	// </div>
+}
+
+func Example_titleSpan() {
+	src := []byte(`
+!!!tip A span
+Body
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithTitleElement(admonitions.TitleSpan)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <span class="adm-title">A span</span>
+	//   <div class="adm-body">
+	// <p>Body</p>
+	//   </div>
+	// </div>
+}
+
+func ExampleWithTitleFunc() {
+	src := []byte(`
+!!!warning
+Keep the lid closed.
+!!!
+
+!!!note Authored
+Kept as is.
+!!!
+`)
+
+	german := map[string]string{"warning": "Warnung"}
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithTitleFunc(func(n *admonitions.Admonition) string {
+				if len(n.Title) > 0 {
+					return string(n.Title)
+				}
+				return german[string(n.AdmonitionClass)]
+			})),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Warnung</div>
+	//   <div class="adm-body">
+	// <p>Keep the lid closed.</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Authored</div>
+	//   <div class="adm-body">
+	// <p>Kept as is.</p>
+	//   </div>
+	// </div>
+}
+
+func ExampleWithTitleFilter() {
+	src := []byte(`
+!!!danger Danger: high voltage
+Don't touch.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithTitleFilter(strings.NewReplacer("Danger", "Critical").Replace),
+				admonitions.WithTarget(admonitions.TargetConfluence),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <ac:structured-macro ac:name="warning"><ac:parameter ac:name="icon">true</ac:parameter><ac:parameter ac:name="title">Critical: high voltage</ac:parameter><ac:rich-text-body>
+	// <p>Don't touch.</p>
+	// </ac:rich-text-body></ac:structured-macro>
+}
+
+func ExampleWithCompactTitleOnly() {
+	src := []byte(`
+> [!TIP] See the FAQ
+
+!!!note Nothing but a title
+!!!
+
+> [!WARNING] With a body
+> is rendered as always.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithCompactTitleOnly(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-tip adm-title-only" data-admonition="0">
+	//   <div class="adm-title">See the FAQ</div>
+	// </div>
+	// <div class="admonition adm-note adm-title-only" data-admonition="0">
+	//   <div class="adm-title">Nothing but a title</div>
+	// </div>
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">With a body</div>
+	//   <div class="adm-body">
+	// <p>is rendered as always.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,105 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_exactMarkers() {
+	src := []byte(`
+!!!note "Quoted" -- title
+It's -- "typographed"
+!!!
+
+> It's a note -- "as written"
+> and the "rest" is typographed
+
+> "Just" a quote
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			extension.Typographer,
+			admonitions.New(
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+				admonitions.WithKeywordsAnywhere(),
+				admonitions.WithExactMarkers(),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">&quot;Quoted&quot; -- title</div>
+	//   <div class="adm-body">
+	// <p>It&rsquo;s &ndash; &ldquo;typographed&rdquo;</p>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>It's a note -- &quot;as written&quot;
+	// and the &ldquo;rest&rdquo; is typographed</p>
+	//   </div>
+	// </div>
+	// <blockquote>
+	// <p>&ldquo;Just&rdquo; a quote</p>
+	// </blockquote>
+}
+
+func Example_exactMarkersTitle() {
+	src := []byte(`
+> [!WARNING] Don't "touch"
+> Really.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			extension.Typographer,
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote), admonitions.WithExactMarkers()),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-warning" data-admonition="0">
+	//   <div class="adm-title">Don't &quot;touch&quot;</div>
+	//   <div class="adm-body">
+	// <p>Really.</p>
+	//   </div>
+	// </div>
+}
+
+func Example_typographedTitle() {
+	src := []byte(`
+> [!NOTE] "Quoted" -- title
+> Body.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			extension.Typographer,
+			admonitions.New(admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">“Quoted” – title</div>
+	//   <div class="adm-body">
+	// <p>Body.</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,41 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_unwrap() {
+	src := []byte(`
+!!!note A title
+The *body* stays.
+
+!!!tip
+So does a nested one.
+!!!
+!!!
+
+> [!WARNING]
+> The marker is gone.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(
+				admonitions.WithUnwrap(true),
+				admonitions.WithBlockQuoteAdmonitions(admonitions.EndOfQuote),
+			),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <p>The <em>body</em> stays.</p>
+	// <p>So does a nested one.</p>
+	// <p>The marker is gone.</p>
+}
@@ -0,0 +1,65 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_vars() {
+	src := []byte(`
+!!!note {{product}} {{version}} is out
+Update to *{{version}}* now,
+{{product_name}} {{unknown}} <{{tag}}>
+!!!
+
+{{version}} isn't replaced outside of admonitions.
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithVars(map[string]string{
+				"product":      "Goldmark",
+				"product_name": "goldmark-admonitions",
+				"version":      "v1.2",
+				"tag":          "<b>",
+			})),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Goldmark v1.2 is out</div>
+	//   <div class="adm-body">
+	// <p>Update to <em>v1.2</em> now,
+	// goldmark-admonitions {{unknown}} &lt;&lt;b&gt;&gt;</p>
+	//   </div>
+	// </div>
+	// <p>{{version}} isn't replaced outside of admonitions.</p>
+}
+
+func Example_varsCodeSpan() {
+	src := []byte("!!!note T\n`{{v}}` and {{v}}\n!!!\n")
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithVars(map[string]string{"v": "1"})),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">T</div>
+	//   <div class="adm-body">
+	// <p><code>{{v}}</code> and 1</p>
+	//   </div>
+	// </div>
+}
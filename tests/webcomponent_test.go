@@ -0,0 +1,70 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func Example_webComponent() {
+	src := []byte(`
+!!!warning Mind the "gap" {#gap}
+Stand *back*.
+!!!
+`)
+
+	for _, tag := range []string{"", "acme-callout"} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(
+					admonitions.WithTarget(admonitions.TargetWebComponent),
+					admonitions.WithComponentTag(tag),
+				),
+			),
+		)
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <doc-admonition type="warning" title="Mind the &quot;gap&quot;" id="gap" class="admonition adm-warning" data-admonition="0">
+	// <p>Stand <em>back</em>.</p>
+	// </doc-admonition>
+	// <acme-callout type="warning" title="Mind the &quot;gap&quot;" id="gap" class="admonition adm-warning" data-admonition="0">
+	// <p>Stand <em>back</em>.</p>
+	// </acme-callout>
+}
+
+func Example_webComponentInvalidTag() {
+	src := []byte(`
+!!!note Hi
+!!!
+`)
+
+	for _, tag := range []string{"callout", "Acme-Callout", `x-a"><script>`, "acme callout", "font-face"} {
+		markdown := goldmark.New(
+			goldmark.WithExtensions(
+				admonitions.New(
+					admonitions.WithTarget(admonitions.TargetWebComponent),
+					admonitions.WithComponentTag(tag),
+				),
+			),
+		)
+		doc := markdown.Parser().Parse(text.NewReader(src))
+		markdown.Renderer().Render(os.Stdout, src, doc)
+	}
+
+	// Output:
+	// <doc-admonition type="note" title="Hi" class="admonition adm-note" data-admonition="0">
+	// </doc-admonition>
+	// <doc-admonition type="note" title="Hi" class="admonition adm-note" data-admonition="0">
+	// </doc-admonition>
+	// <doc-admonition type="note" title="Hi" class="admonition adm-note" data-admonition="0">
+	// </doc-admonition>
+	// <doc-admonition type="note" title="Hi" class="admonition adm-note" data-admonition="0">
+	// </doc-admonition>
+	// <doc-admonition type="note" title="Hi" class="admonition adm-note" data-admonition="0">
+	// </doc-admonition>
+}
@@ -0,0 +1,53 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/text"
+)
+
+func ExampleWithWordLimit() {
+	src := []byte(`
+!!!note Installation
+Download the latest release.
+
+Unpack it into a directory of your choice.
+
+Add the directory to your PATH.
+!!!
+
+!!!tip
+Short enough.
+!!!
+`)
+
+	markdown := goldmark.New(
+		goldmark.WithExtensions(
+			admonitions.New(admonitions.WithWordLimit(8)),
+		),
+	)
+
+	doc := markdown.Parser().Parse(text.NewReader(src))
+	markdown.Renderer().Render(os.Stdout, src, doc)
+
+	// Output:
+	// <div class="admonition adm-note" data-admonition="0">
+	//   <div class="adm-title">Installation</div>
+	//   <div class="adm-body">
+	// <p>Download the latest release.</p>
+	// <details class="adm-more">
+	// <summary>Show more</summary>
+	// <p>Unpack it into a directory of your choice.</p>
+	// <p>Add the directory to your PATH.</p>
+	// </details>
+	//   </div>
+	// </div>
+	// <div class="admonition adm-tip" data-admonition="0">
+	//   <div class="adm-title"></div>
+	//   <div class="adm-body">
+	// <p>Short enough.</p>
+	//   </div>
+	// </div>
+}
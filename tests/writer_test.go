@@ -0,0 +1,26 @@
+package admonitions_test
+
+import (
+	"os"
+
+	admonitions "github.com/PGlesmann/goldmark-admonitions"
+)
+
+func Example_renderToWriter() {
+	node := admonitions.NewAdmonition()
+	node.Title = []byte("Rendered <elsewhere>")
+	node.SetAttributeString("class", []byte("admonition adm-note"))
+
+	r := &admonitions.Renderer{}
+	_ = r.RenderOpening(os.Stdout, node)
+	os.Stdout.WriteString("<p>Streamed body</p>\n")
+	_ = r.RenderClosing(os.Stdout, node)
+
+	// Output:
+	// <div class="admonition adm-note">
+	//   <div class="adm-title">Rendered &lt;elsewhere&gt;</div>
+	//   <div class="adm-body">
+	// <p>Streamed body</p>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,112 @@
+package admonitions
+
+import (
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// A TicketLink links references to tickets matching Pattern, e.g.
+// `\bJIRA-\d+\b` or `#(\d+)\b`, to URL. $1 and the like in URL are replaced
+// by the groups of the match as by regexp.Expand, $0 by all of it.
+type TicketLink struct {
+	Pattern *regexp.Regexp
+	URL     string
+}
+
+// ticketMatch is a reference to a ticket within a text and the URL it links
+// to
+type ticketMatch struct {
+	start, stop int
+	url         []byte
+}
+
+// ticketMatches returns the references to tickets in value in order. Where
+// matches of several links overlap the first one starting wins.
+func ticketMatches(links []TicketLink, value []byte) []ticketMatch {
+	var matches []ticketMatch
+	for start := 0; start < len(value); {
+		best := ticketMatch{start: -1}
+		for _, link := range links {
+			match := link.Pattern.FindSubmatchIndex(value[start:])
+			if match == nil || match[0] == match[1] || (best.start >= 0 && start+match[0] >= best.start) {
+				continue
+			}
+			best = ticketMatch{
+				start: start + match[0],
+				stop:  start + match[1],
+				url:   link.Pattern.Expand(nil, []byte(link.URL), value[start:], match),
+			}
+		}
+		if best.start < 0 {
+			break
+		}
+		matches = append(matches, best)
+		start = best.stop
+	}
+	return matches
+}
+
+// ticketsTransformer turns references to tickets in the texts of admonition
+// bodies into links. Code and existing links are left alone.
+type ticketsTransformer struct {
+	links []TicketLink
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *ticketsTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+
+	var texts []*ast.Text
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node.Kind() {
+		case ast.KindCodeSpan, ast.KindLink, ast.KindAutoLink, ast.KindRawHTML:
+			return ast.WalkSkipChildren, nil
+		}
+		if n, ok := node.(*ast.Text); ok && insideAdmonition(n) && !n.IsRaw() {
+			mergeFollowingTexts(n)
+			texts = append(texts, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, n := range texts {
+		value := n.Segment.Value(source)
+		parent := n.Parent()
+		start := 0
+		for _, match := range ticketMatches(t.links, value) {
+			if match.start > start {
+				before := ast.NewTextSegment(text.NewSegment(n.Segment.Start+start, n.Segment.Start+match.start))
+				parent.InsertBefore(parent, n, before)
+			}
+			link := ast.NewLink()
+			link.Destination = match.url
+			link.AppendChild(link, ast.NewTextSegment(text.NewSegment(n.Segment.Start+match.start, n.Segment.Start+match.stop)))
+			parent.InsertBefore(parent, n, link)
+			start = match.stop
+		}
+		n.Segment = n.Segment.WithStart(n.Segment.Start + start)
+	}
+}
+
+// writeTitleTickets writes the escaped title with references to tickets
+// linked and abbreviations expanded in the rest
+func (r *Renderer) writeTitleTickets(w util.BufWriter, title []byte) {
+	start := 0
+	for _, match := range ticketMatches(r.TicketLinks, title) {
+		r.writeTitleAbbreviations(w, title[start:match.start])
+		_, _ = w.WriteString(`<a href="`)
+		_, _ = w.Write(util.EscapeHTML(util.URLEscape(match.url, false)))
+		_, _ = w.WriteString(`">`)
+		r.writeText(w, title[match.start:match.stop])
+		_, _ = w.WriteString(`</a>`)
+		start = match.stop
+	}
+	r.writeTitleAbbreviations(w, title[start:])
+}
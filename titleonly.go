@@ -0,0 +1,12 @@
+package admonitions
+
+// titleOnlyClass is added to the class of title-only admonitions rendered
+// compactly
+const titleOnlyClass = "adm-title-only"
+
+// isTitleOnly reports whether n is rendered as a compact title-only
+// admonition, i.e. CompactTitleOnly is set and n has nothing but its marker
+// line, like "> [!TIP] See the FAQ"
+func (r *Renderer) isTitleOnly(n *Admonition) bool {
+	return r.CompactTitleOnly && n.FirstChild() == nil
+}
@@ -0,0 +1,430 @@
+package admonitions
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// BlockQuoteTypesKey is the parser.Context key under which the classification
+// of every blockquote of a document is stored as a BlockQuoteTypeMap. Other
+// extensions can read it with BlockQuoteTypes once the document is parsed.
+var BlockQuoteTypesKey = parser.NewContextKey()
+
+// BlockQuoteTypeMap maps blockquote nodes to their BlockQuoteType
+type BlockQuoteTypeMap map[ast.Node]BlockQuoteType
+
+// Type returns the type of the given blockquote. Nodes that weren't
+// classified are of type None.
+func (m BlockQuoteTypeMap) Type(node ast.Node) BlockQuoteType {
+	if t, ok := m[node]; ok {
+		return t
+	}
+	return None
+}
+
+// BlockQuoteTypes returns the classification stored in pc. It is nil if the
+// document hasn't been parsed with the Extender.
+func BlockQuoteTypes(pc parser.Context) BlockQuoteTypeMap {
+	if types, ok := pc.Get(BlockQuoteTypesKey).(BlockQuoteTypeMap); ok {
+		return types
+	}
+	return nil
+}
+
+// BlockQuoteEnd decides where an admonition made from a blockquote ends, see
+// WithBlockQuoteAdmonitions. The rest of the blockquote stays a blockquote.
+type BlockQuoteEnd int
+
+const (
+	EndOfQuote     BlockQuoteEnd = iota // the whole blockquote is the admonition, like on GitHub
+	EndMarker                           // a "> [!END]" line ends the admonition
+	EndAtBlankLine                      // the first blank line within the blockquote ends the admonition
+)
+
+// blockQuoteTransformer classifies all blockquotes of a document and stores
+// the result in the parser.Context. If convert is set, classified blockquotes
+// are replaced by Admonition nodes.
+type blockQuoteTransformer struct {
+	convert      bool
+	end          BlockQuoteEnd
+	exactMarkers bool // whether marker lines are classified as written, see WithExactMarkers
+	failFast     bool // whether unknown alert types are reported as Problems
+
+	boldLabels bool      // whether labels like "**Warning:**" are stripped
+	boldLabel  BoldLabel // what becomes of the rest of their line
+
+	steps *regexp.Regexp // the names of stepped markers, see WithStepAlerts
+
+	customAlerts  bool // whether alerts of unknown types are converted, see WithCustomAlerts
+	caseSensitive bool // whether alert markers have to be uppercase, see WithCaseSensitiveMarkers
+	strictMarkers bool // whether alert markers have to be written exactly, see WithStrictAlertMarkers
+
+	scope            ClassificationScope // where legacy keywords classify blockquotes, see WithClassificationScope
+	keywordsAnywhere bool                // whether keywords within words convert blockquotes, see WithKeywordsAnywhere
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *blockQuoteTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+	types := make(BlockQuoteTypeMap)
+	restoreColonMarkers(pc, source)
+
+	var quotes []ast.Node
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if node.Kind() == ast.KindBlockquote && entering {
+			types[node] = t.classify(node, source)
+			quotes = append(quotes, node)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	if t.failFast {
+		for _, node := range quotes {
+			if name, ok := unknownAlert(node, source); ok && types[node] == None {
+				insertProblem(node, source, "unknown alert type %q", name)
+			}
+		}
+	}
+
+	if t.convert {
+		// outer quotes come first, so nested ones are converted inside the
+		// admonitions made of them
+		for _, node := range quotes {
+			if bqType := types[node]; bqType != None && (t.keywordsAnywhere || leadingClassification(node, bqType, source)) {
+				types[t.convertBlockQuote(node, bqType, source)] = bqType
+			}
+		}
+	}
+
+	pc.Set(BlockQuoteTypesKey, types)
+}
+
+// convertBlockQuote replaces quote with an Admonition holding its children and
+// returns it
+func (t *blockQuoteTransformer) convertBlockQuote(quote ast.Node, bqType BlockQuoteType, source []byte) *Admonition {
+	n := NewAdmonition()
+	n.AdmonitionClass = []byte(bqType.String())
+	if n.AlertType = alertType(quote, source); bqType == Custom {
+		n.AdmonitionClass = bytes.ToLower(n.AlertType)
+	}
+	n.SetAttributeString("class", admonitionClassAttribute(n.AdmonitionClass))
+	n.SetAttributeString("data-admonition", []byte(fmt.Sprint(admonitionDepth(quote))))
+	if n.Severity = alertSeverity(quote, source); n.Severity > 0 {
+		n.SetAttributeString(severityAttribute, []byte(fmt.Sprint(n.Severity)))
+	}
+	if paragraph, ok := quote.FirstChild().(*ast.Paragraph); ok && paragraph.Lines().Len() > 0 {
+		n.Opener = paragraph.Lines().At(0)
+		n.Body = text.NewSegment(n.Opener.Stop, n.Opener.Stop)
+		if _, stop, ok := sourceRange(quote); ok && stop > n.Opener.Stop {
+			n.Body.Stop = stop
+		}
+	}
+
+	blockQuoteMarker(n, quote, bqType, t.boldLabels, source)
+
+	number, step := stepNumber(quote, t.steps, source)
+	title, fold := removeAlertMarker(quote, source)
+	title, attrs := titleAttributes(title)
+	n.Title = title
+	switch fold {
+	case '+':
+		n.SetAttribute(collapseAttribute, []byte("open"))
+		n.DefaultOpen = true
+	case '-':
+		n.SetAttribute(collapseAttribute, []byte("closed"))
+	}
+	setAttributes(n, attrs)
+	if step && bqType == Step {
+		n.SetAttribute(stepAttribute, []byte(fmt.Sprint(number)))
+		if len(n.Title) > 0 {
+			n.Title = []byte(fmt.Sprintf("Step %d: %s", number, n.Title))
+		} else {
+			n.Title = []byte(fmt.Sprintf("Step %d", number))
+		}
+	}
+	if t.boldLabels {
+		if title := stripBoldLabel(quote, bqType, t.boldLabel, source); title != nil {
+			n.Title = title
+		}
+	}
+
+	parent := quote.Parent()
+	parent.ReplaceChild(parent, quote, n)
+	for child := quote.FirstChild(); child != nil; child = quote.FirstChild() {
+		n.AppendChild(n, child)
+	}
+	renumberAdmonitions(n)
+
+	// ========================================================================== //
+	// 	Move everything after the end of the admonition into a new blockquote
+
+	var rest ast.Node
+	switch t.end {
+	case EndMarker:
+		rest = splitAtEndMarker(n, source)
+	case EndAtBlankLine:
+		for child := n.FirstChild(); child != nil && child.NextSibling() != nil; child = child.NextSibling() {
+			if blankLineBetween(child, child.NextSibling(), source) {
+				rest = child.NextSibling()
+				break
+			}
+		}
+	}
+	if rest != nil {
+		quote := ast.NewBlockquote()
+		for rest != nil {
+			next := rest.NextSibling()
+			quote.AppendChild(quote, rest)
+			rest = next
+		}
+		parent.InsertAfter(parent, n, quote)
+
+		// the body ends with the last line left, which may be a lazy
+		// continuation line without a ">"
+		if _, stop, ok := sourceRange(n); ok && stop > n.Body.Start {
+			n.Body.Stop = stop
+		} else {
+			n.Body.Stop = n.Body.Start
+		}
+	}
+
+	return n
+}
+
+// alertMarker returns the last text of the marker "[!NAME]" starting at node,
+// which GitHub alerts are made of, and the name. The marker has to be on a
+// line of its own, like "[!END]".
+func alertMarker(node ast.Node, source []byte) (*ast.Text, string, bool) {
+	right, name, ok := titledAlertMarker(node, source)
+	if !ok || (right.NextSibling() != nil && !right.SoftLineBreak() && !right.HardLineBreak()) {
+		return nil, "", false
+	}
+	return right, name, true
+}
+
+// titledAlertMarker is alertMarker for markers that may be followed by a
+// title on the same line, e.g. "[!WARNING] Don't do this". The "]" is split
+// into a text of its own.
+func titledAlertMarker(node ast.Node, source []byte) (*ast.Text, string, bool) {
+	left, ok := node.(*ast.Text)
+	if !ok || string(left.Segment.Value(source)) != "[" {
+		return nil, "", false
+	}
+	if previous, ok := left.PreviousSibling().(*ast.Text); ok && !previous.SoftLineBreak() && !previous.HardLineBreak() {
+		return nil, "", false
+	}
+	mid, ok := left.NextSibling().(*ast.Text)
+	if !ok {
+		return nil, "", false
+	}
+	name := mid.Segment.Value(source)
+	if len(name) < 2 || name[0] != '!' {
+		return nil, "", false
+	}
+	if i := bytes.IndexByte(name, ']'); i > 1 {
+		splitText(mid, i)
+		name = name[:i]
+	}
+	if i := bytes.IndexByte(name, ':'); i > 1 && !isSeverity(bytes.TrimSpace(name[i+1:])) {
+		// "[!WARNING:23]" has no severity like "[!WARNING:2]"
+		return nil, "", false
+	}
+	right, ok := mid.NextSibling().(*ast.Text)
+	if !ok || !bytes.HasPrefix(right.Segment.Value(source), []byte("]")) {
+		return nil, "", false
+	}
+	if right.Segment.Len() > 1 {
+		splitText(right, 1)
+	}
+	return right, string(name[1:]), true
+}
+
+// splitText splits t after i bytes, goldmark keeps the "]" of a marker
+// followed by a title in one text with its name or the title
+func splitText(t *ast.Text, i int) {
+	parent := t.Parent()
+	after := ast.NewTextSegment(t.Segment.WithStart(t.Segment.Start + i))
+	after.SetSoftLineBreak(t.SoftLineBreak())
+	after.SetHardLineBreak(t.HardLineBreak())
+	parent.InsertAfter(parent, t, after)
+	t.Segment = t.Segment.WithStop(t.Segment.Start + i)
+	t.SetSoftLineBreak(false)
+	t.SetHardLineBreak(false)
+}
+
+// unknownAlert returns the name of the alert marker quote starts with, if
+// there is one. "[!END]" isn't one.
+func unknownAlert(quote ast.Node, source []byte) (string, bool) {
+	paragraph, ok := quote.FirstChild().(*ast.Paragraph)
+	if !ok {
+		return "", false
+	}
+	_, name, ok := titledAlertMarker(paragraph.FirstChild(), source)
+	return name, ok && !strings.EqualFold(name, "end")
+}
+
+// removeAlertMarker removes the GitHub alert marker from the first paragraph
+// of quote, and the paragraph if nothing else is left. The rest of the line
+// of the marker is removed as well and returned as the title, without the
+// "+" or "-" of Obsidian's foldable callouts, e.g. "[!note]+ Title", which is
+// returned as fold.
+func removeAlertMarker(quote ast.Node, source []byte) (title []byte, fold byte) {
+	paragraph, ok := quote.FirstChild().(*ast.Paragraph)
+	if !ok {
+		return nil, 0
+	}
+
+	if last, _, ok := titledAlertMarker(paragraph.FirstChild(), source); ok {
+		for child := paragraph.FirstChild(); child != last; child = paragraph.FirstChild() {
+			paragraph.RemoveChild(paragraph, child)
+		}
+		paragraph.RemoveChild(paragraph, last)
+		if !last.SoftLineBreak() && !last.HardLineBreak() {
+			title = takeLine(paragraph, source)
+		}
+	} else if paragraph.Lines().Len() > 0 {
+		// e.g. a marker line kept as written by WithExactMarkers, or one with
+		// spaces like "[ !NOTE]"
+		line := paragraph.Lines().At(0)
+		marker := alertLine.FindIndex(line.Value(source))
+		if marker == nil {
+			return nil, 0
+		}
+		removed, endsLine := removeLinePrefix(paragraph, line.Start+marker[1])
+		if !removed {
+			return nil, 0
+		}
+		if !endsLine {
+			title = takeLine(paragraph, source)
+		}
+	}
+
+	if paragraph.FirstChild() == nil {
+		quote.RemoveChild(quote, paragraph)
+	}
+	if trimmed := bytes.TrimLeft(title, " \t"); len(trimmed) > 0 && trimmed[0] == ':' {
+		// "[!NOTE]: Title"
+		title = trimmed[1:]
+	} else if len(title) > 0 && (title[0] == '+' || title[0] == '-') {
+		fold, title = title[0], title[1:]
+	}
+	return bytes.TrimSpace(title), fold
+}
+
+// takeLine removes the inlines of the first line of paragraph and returns
+// their text. Strings holding HTML, like the entities of the typographer, are
+// unescaped, as titles are escaped when they are rendered.
+func takeLine(paragraph *ast.Paragraph, source []byte) []byte {
+	var line []byte
+	for child := paragraph.FirstChild(); child != nil; child = paragraph.FirstChild() {
+		switch c := child.(type) {
+		case *ast.Text:
+			line = append(line, c.Segment.Value(source)...)
+		case *ast.String:
+			if c.IsCode() {
+				line = append(line, html.UnescapeString(string(c.Value))...)
+			} else {
+				line = append(line, c.Value...)
+			}
+		default:
+			line = append(line, plainText(child, source)...)
+		}
+		paragraph.RemoveChild(paragraph, child)
+		if endsLine(child) {
+			break
+		}
+	}
+	return line
+}
+
+// splitAtEndMarker removes the first "[!END]" line from the children of n and
+// returns the first block following it. Paragraphs containing the marker are
+// split in two.
+func splitAtEndMarker(n *Admonition, source []byte) ast.Node {
+	for block := n.FirstChild(); block != nil; block = block.NextSibling() {
+		paragraph, ok := block.(*ast.Paragraph)
+		if !ok {
+			continue
+		}
+		for child := paragraph.FirstChild(); child != nil; child = child.NextSibling() {
+			last, name, ok := alertMarker(child, source)
+			if !ok || !strings.EqualFold(name, "end") {
+				continue
+			}
+
+			// the inlines after the marker move into a paragraph of their own
+			after := ast.NewParagraph()
+			for next := last.NextSibling(); next != nil; next = last.NextSibling() {
+				after.AppendChild(after, next)
+			}
+			for next := child.NextSibling(); next != last; next = child.NextSibling() {
+				paragraph.RemoveChild(paragraph, next)
+			}
+			previous := child.PreviousSibling()
+			paragraph.RemoveChild(paragraph, last)
+			paragraph.RemoveChild(paragraph, child)
+			if t, ok := previous.(*ast.Text); ok {
+				t.SetSoftLineBreak(false)
+				t.SetHardLineBreak(false)
+			}
+
+			rest := paragraph.NextSibling()
+			if after.FirstChild() != nil {
+				n.InsertAfter(n, paragraph, after)
+				rest = after
+			}
+			if paragraph.FirstChild() == nil {
+				n.RemoveChild(n, paragraph)
+			}
+			return rest
+		}
+	}
+	return nil
+}
+
+// blankLineBetween reports whether there is a blank line, apart from
+// blockquote markers, between the blocks a and b
+func blankLineBetween(a, b ast.Node, source []byte) bool {
+	_, stop, okA := sourceRange(a)
+	start, _, okB := sourceRange(b)
+	if !okA || !okB || stop > start {
+		return false
+	}
+	lines := bytes.Split(source[stop:start], []byte{'\n'})
+	// the last line is the beginning of the line b starts on
+	for _, line := range lines[:len(lines)-1] {
+		if len(bytes.Trim(line, " \t>")) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// renumberAdmonitions updates the data-admonition depth of the admonitions
+// within n, which were parsed as part of a blockquote
+func renumberAdmonitions(n *Admonition) {
+	_ = ast.Walk(n, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if inner, ok := node.(*Admonition); ok && entering && inner != n {
+			inner.SetAttributeString("data-admonition", []byte(fmt.Sprint(admonitionDepth(inner))))
+		}
+		return ast.WalkContinue, nil
+	})
+}
+
+// admonitionDepth returns the number of admonitions node is nested in
+func admonitionDepth(node ast.Node) int {
+	depth := 0
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Kind() == KindAdmonition {
+			depth++
+		}
+	}
+	return depth
+}
@@ -0,0 +1,91 @@
+package admonitions
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// exactMarkerLine returns the inlines of the first line of the first
+// paragraph of quote and a single text of its source to replace them with.
+// It returns no text unless the line contains strings inserted by inline
+// extensions like the typographer, and otherwise only texts.
+func exactMarkerLine(quote ast.Node, source []byte) (*ast.Paragraph, []ast.Node, *ast.Text) {
+	paragraph, ok := quote.FirstChild().(*ast.Paragraph)
+	if !ok || paragraph.Lines().Len() == 0 {
+		return nil, nil, nil
+	}
+
+	var inlines []ast.Node
+	var last *ast.Text
+	typographed := false
+	for child := paragraph.FirstChild(); child != nil && last == nil; child = child.NextSibling() {
+		switch c := child.(type) {
+		case *ast.String:
+			typographed = true
+		case *ast.Text:
+			if c.SoftLineBreak() || c.HardLineBreak() {
+				last = c
+			}
+		default:
+			return nil, nil, nil
+		}
+		inlines = append(inlines, child)
+	}
+	if !typographed || (last == nil && paragraph.Lines().Len() > 1) {
+		return nil, nil, nil
+	}
+
+	line := paragraph.Lines().At(0)
+	line = line.TrimRightSpace(source)
+	if last != nil && last.HardLineBreak() && bytes.HasSuffix(line.Value(source), []byte{'\\'}) {
+		line.Stop--
+	}
+	exact := ast.NewTextSegment(line)
+	if last != nil {
+		exact.SetSoftLineBreak(last.SoftLineBreak())
+		exact.SetHardLineBreak(last.HardLineBreak())
+	}
+	return paragraph, inlines, exact
+}
+
+// replaceInlines replaces the adjacent inlines old of paragraph with new
+func replaceInlines(paragraph *ast.Paragraph, old []ast.Node, new ...ast.Node) {
+	for _, n := range new {
+		paragraph.InsertBefore(paragraph, old[0], n)
+	}
+	for _, n := range old {
+		paragraph.RemoveChild(paragraph, n)
+	}
+}
+
+// classify returns the type of quote, Step for markers matching steps and
+// Custom for other unknown markers with customAlerts. With caseSensitive,
+// quotes with alert markers that aren't uppercase are None, with
+// strictMarkers those with spaces in their markers or a colon following them.
+// With exactMarkers, the first line of classified quotes is kept as written.
+func (t *blockQuoteTransformer) classify(quote ast.Node, source []byte) BlockQuoteType {
+	bqType := classifyBlockQuote(quote, source, t.scope)
+	if _, ok := stepNumber(quote, t.steps, source); ok {
+		bqType = Step
+	} else if name := alertType(quote, source); t.customAlerts && name != nil && !strings.EqualFold(string(name), "end") &&
+		ghAlertsClassifier.ClassifyingBlockQuote("!"+string(name)) == None {
+		// the marker wins over legacy keywords, "[!BUG] note" is a bug
+		bqType = Custom
+	}
+	if name := alertType(quote, source); t.caseSensitive && bqType != Step && name != nil && !bytes.Equal(name, bytes.ToUpper(name)) {
+		// "[!note]" is neither an alert nor a note by its legacy keyword
+		bqType = None
+	}
+	if t.strictMarkers && !strictMarker(quote, source) {
+		// "[! NOTE ]" or "[!NOTE]:" isn't a marker on GitHub
+		bqType = None
+	}
+	if t.exactMarkers && bqType != None {
+		if paragraph, inlines, exact := exactMarkerLine(quote, source); exact != nil {
+			replaceInlines(paragraph, inlines, exact)
+		}
+	}
+	return bqType
+}
@@ -0,0 +1,137 @@
+package admonitions
+
+import (
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// placeholder matches {{name}} with the name in the first group
+var placeholder = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// varsTransformer replaces {{name}} placeholders inside admonition titles and
+// bodies. Placeholders outside of admonitions and unknown names are kept.
+type varsTransformer struct {
+	vars     map[string]string
+	failFast bool // whether unknown names are reported as Problems
+}
+
+// Transform implements parser.ASTTransformer.Transform .
+func (t *varsTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	source := reader.Source()
+
+	var texts []*ast.Text
+	unknown := map[*Admonition]string{}
+	var admonitions []*Admonition
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node.Kind() {
+		case ast.KindCodeSpan, ast.KindCodeBlock, ast.KindFencedCodeBlock, ast.KindHTMLBlock, ast.KindRawHTML:
+			return ast.WalkSkipChildren, nil
+		}
+		if n, ok := node.(*Admonition); ok {
+			admonitions = append(admonitions, n)
+			if name, ok := t.unknownName(n.Title); ok && t.failFast {
+				unknown[n] = name
+			}
+			n.Title = t.substitute(n.Title)
+		}
+		if n, ok := node.(*ast.Text); ok && insideAdmonition(n) {
+			mergeFollowingTexts(n)
+			texts = append(texts, n)
+			if name, ok := t.unknownName(n.Segment.Value(source)); ok && t.failFast {
+				if a := closestAdmonition(n); unknown[a] == "" {
+					unknown[a] = name
+				}
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	if t.failFast {
+		for _, n := range admonitions {
+			if name, ok := unknown[n]; ok {
+				insertProblem(n, source, "unknown placeholder {{%s}}", name)
+			}
+		}
+	}
+
+	// The texts are split after walking, so the walk doesn't see the new nodes
+	for _, n := range texts {
+		t.substituteText(n, source)
+	}
+}
+
+func (t *varsTransformer) substitute(b []byte) []byte {
+	return placeholder.ReplaceAllFunc(b, func(match []byte) []byte {
+		name := placeholder.FindSubmatch(match)[1]
+		if value, ok := t.vars[string(name)]; ok {
+			return []byte(value)
+		}
+		return match
+	})
+}
+
+// unknownName returns the first name of a placeholder in b without a value
+func (t *varsTransformer) unknownName(b []byte) (string, bool) {
+	for _, match := range placeholder.FindAllSubmatch(b, -1) {
+		if _, ok := t.vars[string(match[1])]; !ok {
+			return string(match[1]), true
+		}
+	}
+	return "", false
+}
+
+// substituteText splits n into the text around the placeholders and strings
+// with their values. The remainder stays in n, keeping its line break.
+func (t *varsTransformer) substituteText(n *ast.Text, source []byte) {
+	value := n.Segment.Value(source)
+	parent := n.Parent()
+
+	start := 0
+	for _, match := range placeholder.FindAllSubmatchIndex(value, -1) {
+		replacement, ok := t.vars[string(value[match[2]:match[3]])]
+		if !ok {
+			continue
+		}
+		if match[0] > start {
+			before := ast.NewTextSegment(text.NewSegment(n.Segment.Start+start, n.Segment.Start+match[0]))
+			before.SetRaw(n.IsRaw())
+			parent.InsertBefore(parent, n, before)
+		}
+		parent.InsertBefore(parent, n, ast.NewString([]byte(replacement)))
+		start = match[1]
+	}
+
+	n.Segment = n.Segment.WithStart(n.Segment.Start + start)
+}
+
+// mergeFollowingTexts merges the text siblings directly following n into n, as
+// long as they continue its segment on the same line. Delimiters like "_"
+// otherwise split a placeholder into several texts.
+func mergeFollowingTexts(n *ast.Text) {
+	for !n.SoftLineBreak() && !n.HardLineBreak() {
+		next, ok := n.NextSibling().(*ast.Text)
+		if !ok || next.Segment.Start != n.Segment.Stop || next.IsRaw() != n.IsRaw() {
+			return
+		}
+		n.Segment = n.Segment.WithStop(next.Segment.Stop)
+		n.SetSoftLineBreak(next.SoftLineBreak())
+		n.SetHardLineBreak(next.HardLineBreak())
+		n.Parent().RemoveChild(n.Parent(), next)
+	}
+}
+
+// insideAdmonition reports whether node is a descendant of an Admonition
+func insideAdmonition(node ast.Node) bool {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Kind() == KindAdmonition {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package admonitions
+
+import (
+	"regexp"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// defaultComponentTag is the custom element TargetWebComponent renders
+var defaultComponentTag = "doc-admonition"
+
+// customElementName matches valid names of custom elements: lowercase,
+// starting with a letter and containing a hyphen
+var customElementName = regexp.MustCompile(`^[a-z][a-z0-9._]*-[a-z0-9._-]*$`)
+
+// reservedElementNames are the names with a hyphen SVG and MathML use, which
+// custom elements can't have
+var reservedElementNames = map[string]bool{
+	"annotation-xml":   true,
+	"color-profile":    true,
+	"font-face":        true,
+	"font-face-src":    true,
+	"font-face-uri":    true,
+	"font-face-format": true,
+	"font-face-name":   true,
+	"missing-glyph":    true,
+}
+
+// componentTag returns the custom element admonitions are rendered as,
+// defaultComponentTag unless ComponentTag is a valid custom element name
+func (r *Renderer) componentTag() string {
+	if !customElementName.MatchString(r.ComponentTag) || reservedElementNames[r.ComponentTag] {
+		return defaultComponentTag
+	}
+	return r.ComponentTag
+}
+
+// renderWebComponent renders n as a custom element with its class as type
+// and its title as attributes:
+//
+//	<doc-admonition type="warning" title="Careful" class="admonition adm-warning">
+func (r *Renderer) renderWebComponent(w util.BufWriter, source []byte, n *Admonition, entering bool) (ast.WalkStatus, error) {
+	tag := r.componentTag()
+
+	if !entering {
+		_, _ = w.WriteString("</" + tag + ">\n")
+		return ast.WalkContinue, nil
+	}
+
+	_, _ = w.WriteString("<" + tag + ` type="`)
+	_, _ = w.Write(util.EscapeHTML(n.AdmonitionClass))
+	_, _ = w.WriteString(`"`)
+	if title := r.title(n); len(title) > 0 {
+		_, _ = w.WriteString(` title="`)
+		_, _ = w.Write(util.EscapeHTML(title))
+		_, _ = w.WriteString(`"`)
+	}
+	r.writeAttributes(w, n)
+	_, _ = w.WriteString(">\n")
+	return ast.WalkContinue, nil
+}
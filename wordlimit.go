@@ -0,0 +1,38 @@
+package admonitions
+
+import (
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/util"
+)
+
+// truncationPoint returns the first block of the body of n that is beyond
+// WordLimit, or nil if the whole body is within it. Bodies are only cut
+// between blocks and the first block is always shown.
+func (r *Renderer) truncationPoint(n *Admonition, source []byte) ast.Node {
+	words := 0
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		words += len(strings.Fields(string(plainText(child, source))))
+		if words > r.WordLimit && child != n.FirstChild() {
+			return child
+		}
+	}
+	return nil
+}
+
+// renderTruncated renders the opening and the body of n with the blocks from
+// cut on in a collapsed <details> element. Leaving n closes it as usual.
+func (r *Renderer) renderTruncated(w util.BufWriter, source []byte, n *Admonition, cut ast.Node) (ast.WalkStatus, error) {
+	r.writeOpening(w, source, n)
+	for child := n.FirstChild(); child != nil; child = child.NextSibling() {
+		if child == cut {
+			_, _ = w.WriteString("<details" + r.classAttribute("adm-more", string(n.AdmonitionClass)) + ">\n<summary>Show more</summary>\n")
+		}
+		if err := r.markdown.Render(w, source, child); err != nil {
+			return ast.WalkStop, err
+		}
+	}
+	_, _ = w.WriteString("</details>\n")
+	return ast.WalkSkipChildren, nil
+}
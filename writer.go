@@ -0,0 +1,19 @@
+package admonitions
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/yuin/goldmark/util"
+)
+
+// asBufWriter adapts any io.Writer to the util.BufWriter goldmark renders to.
+// Writers that already are a util.BufWriter are used as they are, everything
+// else is buffered internally. flush has to be called when done writing.
+func asBufWriter(w io.Writer) (bw util.BufWriter, flush func() error) {
+	if bw, ok := w.(util.BufWriter); ok {
+		return bw, func() error { return nil }
+	}
+	buffered := bufio.NewWriter(w)
+	return buffered, buffered.Flush
+}